@@ -0,0 +1,125 @@
+package analyze
+
+import (
+	"time"
+
+	"github.com/qlik-oss/gopherciser/logger"
+	"github.com/qlik-oss/gopherciser/statistics"
+)
+
+type (
+	// Aggregator rebuilds execution counters and action statistics from log rows
+	Aggregator struct {
+		Counters *statistics.ExecutionCounters
+		// Start time of first row added
+		Start time.Time
+		// End time of last row added
+		End time.Time
+
+		users    map[string]struct{}
+		threads  map[uint64]struct{}
+		sessions map[uint64]struct{}
+		actions  map[uint64]struct{}
+	}
+)
+
+// openAppAction name of open app action in logs, defined here to not depend on the scenario package
+const openAppAction = "openapp"
+
+// NewAggregator creates an aggregator with statistics collection turned on
+func NewAggregator() *Aggregator {
+	collector := statistics.NewCollector()
+	_ = collector.SetLevel(statistics.StatsLevelOn)
+	return &Aggregator{
+		Counters: &statistics.ExecutionCounters{StatisticsCollector: collector},
+		users:    make(map[string]struct{}),
+		threads:  make(map[uint64]struct{}),
+		sessions: make(map[uint64]struct{}),
+		actions:  make(map[uint64]struct{}),
+	}
+}
+
+// Add log row to statistics
+func (agg *Aggregator) Add(row *LogRow) {
+	if !row.Time.IsZero() {
+		if agg.Start.IsZero() || row.Time.Before(agg.Start) {
+			agg.Start = row.Time
+		}
+		if row.Time.After(agg.End) {
+			agg.End = row.Time
+		}
+	}
+
+	if row.User != "" {
+		if _, ok := agg.users[row.User]; !ok {
+			agg.users[row.User] = struct{}{}
+			agg.Counters.Users.Inc()
+		}
+	}
+	if row.Thread > 0 {
+		if _, ok := agg.threads[row.Thread]; !ok {
+			agg.threads[row.Thread] = struct{}{}
+			agg.Counters.Threads.Inc()
+		}
+	}
+	if row.Session > 0 {
+		if _, ok := agg.sessions[row.Session]; !ok {
+			agg.sessions[row.Session] = struct{}{}
+			agg.Counters.Sessions.Inc()
+		}
+	}
+	if row.ActionID > 0 {
+		if _, ok := agg.actions[row.ActionID]; !ok {
+			agg.actions[row.ActionID] = struct{}{}
+			agg.Counters.ActionID.Inc()
+		}
+	}
+
+	switch row.Level {
+	case logger.ErrorLevel.String():
+		agg.Counters.Errors.Inc()
+		agg.addError(row)
+	case logger.WarningLevel.String():
+		agg.Counters.Warnings.Inc()
+	case logger.ResultLevel.String():
+		agg.addResult(row)
+	}
+}
+
+// addResult mimics how statistics are collected for action results during execution
+func (agg *Aggregator) addResult(row *LogRow) {
+	agg.Counters.Requests.Add(row.RequestsSent)
+
+	stats := agg.Counters.StatisticsCollector.GetOrAddActionStats(row.Action, row.Label, row.AppGUID)
+	stats.WarnCount.Add(row.Warnings)
+	stats.ErrCount.Add(row.Errors)
+	stats.Sent.Add(row.Sent)
+	stats.Received.Add(row.Received)
+	stats.Requests.Add(row.RequestsSent)
+	if row.Success {
+		stats.RespAvg.AddSample(uint64(row.ResponseTime))
+		if row.Action == openAppAction {
+			agg.Counters.StatisticsCollector.IncOpenedApps()
+		}
+	} else {
+		stats.Failed.Inc()
+	}
+}
+
+// addError groups error by fingerprint, logs written before errors were classified get a fingerprint from message
+func (agg *Aggregator) addError(row *LogRow) {
+	class := logger.ClassifyError(row.Message, nil)
+	if row.Fingerprint == "" {
+		row.ErrorCategory = class.Category.String()
+		row.Fingerprint = class.Fingerprint
+	}
+	agg.Counters.StatisticsCollector.GetOrAddErrorStats(row.Fingerprint, row.ErrorCategory, row.ErrorCode, class.Message).Add(row.Time)
+}
+
+// Duration between first and last row added
+func (agg *Aggregator) Duration() time.Duration {
+	if agg.Start.IsZero() {
+		return 0
+	}
+	return agg.End.Sub(agg.Start)
+}
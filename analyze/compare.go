@@ -0,0 +1,428 @@
+package analyze
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/InVisionApp/tabular"
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/gopherciser/helpers"
+	"github.com/qlik-oss/gopherciser/logger"
+	"github.com/qlik-oss/gopherciser/statistics"
+)
+
+type (
+	// CompareSettings tolerances used when comparing executions
+	CompareSettings struct {
+		// Tolerance allowed increase of response time in percent
+		Tolerance float64
+		// ErrorRateTolerance allowed increase of error rate in percentage points
+		ErrorRateTolerance float64
+		// BytesTolerance allowed increase of sent and received bytes per action in percent
+		BytesTolerance float64
+		// Metric response time metric used to detect regressions, one of avg, p50, p90, p95, p99 and max
+		Metric string
+		// MinSamples minimum amount of successful actions in both executions to detect response time regressions
+		MinSamples uint64
+	}
+
+	// MetricDelta difference of a metric between baseline and candidate
+	MetricDelta struct {
+		Baseline  float64 `json:"baseline"`
+		Candidate float64 `json:"candidate"`
+		Delta     float64 `json:"delta"`
+		// DeltaPercent relative change in percent, 0 if baseline is 0
+		DeltaPercent float64 `json:"deltaPercent"`
+		Regression   bool    `json:"regression"`
+	}
+
+	// ActionComparison comparison of an unique combination of action and label, response times are in nanoseconds
+	ActionComparison struct {
+		Action           string      `json:"action"`
+		Label            string      `json:"label"`
+		BaselineSamples  uint64      `json:"baselineSamples"`
+		CandidateSamples uint64      `json:"candidateSamples"`
+		AvgResp          MetricDelta `json:"avgResp"`
+		P50              MetricDelta `json:"p50"`
+		P90              MetricDelta `json:"p90"`
+		P95              MetricDelta `json:"p95"`
+		P99              MetricDelta `json:"p99"`
+		Max              MetricDelta `json:"max"`
+		ErrorRate        MetricDelta `json:"errorRate"`
+		SentPerAction    MetricDelta `json:"sentPerAction"`
+		RecvPerAction    MetricDelta `json:"receivedPerAction"`
+		// Missing is set to "baseline" or "candidate" if action only exists in one of the executions
+		Missing    string `json:"missing,omitempty"`
+		Regression bool   `json:"regression"`
+	}
+
+	// Comparison result of comparing two executions
+	Comparison struct {
+		Errors      MetricDelta        `json:"errors"`
+		Warnings    MetricDelta        `json:"warnings"`
+		Actions     []ActionComparison `json:"actions"`
+		Regressions int                `json:"regressions"`
+	}
+
+	// actionAggregate action statistics merged over apps
+	actionAggregate struct {
+		action, label string
+		hist          *statistics.Histogram
+		successful    uint64
+		failed        uint64
+		sent          uint64
+		received      uint64
+	}
+)
+
+// DefaultCompareSettings default tolerances when comparing executions
+func DefaultCompareSettings() CompareSettings {
+	return CompareSettings{
+		Tolerance:          10,
+		ErrorRateTolerance: 1,
+		BytesTolerance:     10,
+		Metric:             "p95",
+		MinSamples:         1,
+	}
+}
+
+// Validate compare settings
+func (settings *CompareSettings) Validate() error {
+	switch strings.ToLower(settings.Metric) {
+	case "avg", "p50", "p90", "p95", "p99", "max":
+	default:
+		return errors.Errorf("unknown compare metric<%s>, expected one of avg, p50, p90, p95, p99 or max", settings.Metric)
+	}
+	if settings.Tolerance < 0 || settings.ErrorRateTolerance < 0 || settings.BytesTolerance < 0 {
+		return errors.New("tolerances must not be negative")
+	}
+	return nil
+}
+
+// LoadSummary reads summary file or rebuilds summary from a TSV or JSON log, logs may be gzip or zstd compressed.
+// Legacy summary files, only containing totals, can't be compared and returns an error.
+func LoadSummary(fileName string) (*statistics.SummaryFile, error) {
+	summaryFile, summaryErr := statistics.ReadSummaryFile(fileName)
+	if summaryErr == nil {
+		if summaryFile.Version == statistics.SummaryFileVersionLegacy {
+			return nil, errors.Errorf("summary file<%s> is of legacy format without action statistics, use a summary file written with version<%d> or the log of the execution",
+				fileName, statistics.SummaryFileVersion)
+		}
+		return summaryFile, nil
+	}
+
+	file, err := logger.OpenFile(fileName)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() { _ = file.Close() }()
+
+	// only parse as log if file starts as a log, otherwise report why it could not be read as a summary file
+	reader := bufio.NewReaderSize(file, logDetectSize)
+	head, _ := reader.Peek(logDetectSize) // error is returned for files smaller than peeked size
+	if !isLogStart(head) {
+		return nil, errors.Wrapf(summaryErr, "file<%s> is neither a summary file nor a log", fileName)
+	}
+
+	agg := NewAggregator()
+	rows := 0
+	if err := ReadLog(reader, func(row *LogRow) error {
+		rows++
+		agg.Add(row)
+		return nil
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to read log<%s>", fileName)
+	}
+	if rows < 1 {
+		return nil, errors.Errorf("log<%s> has no rows", fileName)
+	}
+	return statistics.NewSummaryFile(agg.Duration(), agg.Counters), nil
+}
+
+// Compare candidate execution with baseline execution
+func Compare(baseline, candidate *statistics.SummaryFile, settings CompareSettings) (*Comparison, error) {
+	if err := settings.Validate(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	comparison := &Comparison{
+		Errors:   totalDelta(baseline, candidate, "TotErrors"),
+		Warnings: totalDelta(baseline, candidate, "TotWarnings"),
+	}
+
+	baseActions := mergeActions(baseline)
+	candActions := mergeActions(candidate)
+
+	keys := make([]string, 0, len(baseActions)+len(candActions))
+	for k := range baseActions {
+		keys = append(keys, k)
+	}
+	for k := range candActions {
+		if _, ok := baseActions[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		base, cand := baseActions[k], candActions[k]
+		actionComparison := compareAction(base, cand, &settings)
+		if actionComparison.Regression {
+			comparison.Regressions++
+		}
+		comparison.Actions = append(comparison.Actions, actionComparison)
+	}
+
+	return comparison, nil
+}
+
+func compareAction(base, cand *actionAggregate, settings *CompareSettings) ActionComparison {
+	var comparison ActionComparison
+	switch {
+	case base == nil:
+		comparison.Action, comparison.Label, comparison.Missing = cand.action, cand.label, "baseline"
+		base = &actionAggregate{hist: statistics.NewHistogram()}
+	case cand == nil:
+		comparison.Action, comparison.Label, comparison.Missing = base.action, base.label, "candidate"
+		cand = &actionAggregate{hist: statistics.NewHistogram()}
+	default:
+		comparison.Action, comparison.Label = base.action, base.label
+	}
+
+	comparison.BaselineSamples = base.successful
+	comparison.CandidateSamples = cand.successful
+
+	basePercentiles, candPercentiles := base.hist.Percentiles(), cand.hist.Percentiles()
+	comparison.AvgResp = newMetricDelta(base.hist.Mean(), cand.hist.Mean())
+	comparison.P50 = newMetricDelta(float64(basePercentiles.P50), float64(candPercentiles.P50))
+	comparison.P90 = newMetricDelta(float64(basePercentiles.P90), float64(candPercentiles.P90))
+	comparison.P95 = newMetricDelta(float64(basePercentiles.P95), float64(candPercentiles.P95))
+	comparison.P99 = newMetricDelta(float64(basePercentiles.P99), float64(candPercentiles.P99))
+	comparison.Max = newMetricDelta(float64(basePercentiles.Max), float64(candPercentiles.Max))
+	comparison.ErrorRate = newMetricDelta(base.errorRate(), cand.errorRate())
+	comparison.SentPerAction = newMetricDelta(base.perAction(base.sent), cand.perAction(cand.sent))
+	comparison.RecvPerAction = newMetricDelta(base.perAction(base.received), cand.perAction(cand.received))
+
+	if comparison.Missing != "" {
+		// can't detect regressions for actions only existing in one execution
+		return comparison
+	}
+
+	if base.successful >= settings.MinSamples && cand.successful >= settings.MinSamples {
+		respDelta := comparison.metric(settings.Metric)
+		respDelta.Regression = exceedsPercent(respDelta, settings.Tolerance)
+	}
+	comparison.ErrorRate.Regression = comparison.ErrorRate.Delta > settings.ErrorRateTolerance
+	comparison.SentPerAction.Regression = exceedsPercent(&comparison.SentPerAction, settings.BytesTolerance)
+	comparison.RecvPerAction.Regression = exceedsPercent(&comparison.RecvPerAction, settings.BytesTolerance)
+
+	for _, delta := range []*MetricDelta{comparison.metric(settings.Metric), &comparison.ErrorRate, &comparison.SentPerAction, &comparison.RecvPerAction} {
+		if delta.Regression {
+			comparison.Regression = true
+		}
+	}
+
+	return comparison
+}
+
+// metric returns response time metric delta by name
+func (comparison *ActionComparison) metric(name string) *MetricDelta {
+	switch strings.ToLower(name) {
+	case "avg":
+		return &comparison.AvgResp
+	case "p50":
+		return &comparison.P50
+	case "p90":
+		return &comparison.P90
+	case "p99":
+		return &comparison.P99
+	case "max":
+		return &comparison.Max
+	default:
+		return &comparison.P95
+	}
+}
+
+func exceedsPercent(delta *MetricDelta, tolerance float64) bool {
+	if delta.Delta <= 0 {
+		return false
+	}
+	if delta.Baseline == 0 {
+		return true
+	}
+	return delta.DeltaPercent > tolerance
+}
+
+func newMetricDelta(baseline, candidate float64) MetricDelta {
+	delta := MetricDelta{
+		Baseline:  baseline,
+		Candidate: candidate,
+		Delta:     candidate - baseline,
+	}
+	if baseline != 0 {
+		delta.DeltaPercent = delta.Delta / baseline * 100
+	}
+	return delta
+}
+
+func totalDelta(baseline, candidate *statistics.SummaryFile, shortTitle string) MetricDelta {
+	return newMetricDelta(totalValue(baseline, shortTitle), totalValue(candidate, shortTitle))
+}
+
+func totalValue(summaryFile *statistics.SummaryFile, shortTitle string) float64 {
+	str, ok := summaryFile.Total(shortTitle)
+	if !ok {
+		return 0
+	}
+	v, _ := strconv.ParseFloat(str, 64)
+	return v
+}
+
+func mergeActions(summaryFile *statistics.SummaryFile) map[string]*actionAggregate {
+	actions := make(map[string]*actionAggregate, len(summaryFile.Actions))
+	for _, entry := range summaryFile.Actions {
+		key := entry.Action + "\x00" + entry.Label
+		agg, ok := actions[key]
+		if !ok {
+			agg = &actionAggregate{action: entry.Action, label: entry.Label, hist: statistics.NewHistogram()}
+			actions[key] = agg
+		}
+		agg.hist.Merge(entry.Histogram)
+		agg.successful += entry.Successful
+		agg.failed += entry.Failed
+		agg.sent += entry.Sent
+		agg.received += entry.Received
+	}
+	return actions
+}
+
+func (agg *actionAggregate) errorRate() float64 {
+	total := agg.successful + agg.failed
+	if total < 1 {
+		return 0
+	}
+	return float64(agg.failed) / float64(total) * 100
+}
+
+func (agg *actionAggregate) perAction(v uint64) float64 {
+	total := agg.successful + agg.failed
+	if total < 1 {
+		return 0
+	}
+	return float64(v) / float64(total)
+}
+
+const (
+	ansiReset      = "\x1b[0m"
+	ansiBoldRed    = "\x1b[1;31m"
+	ansiBoldBlue   = "\x1b[1;34m"
+	ansiBoldYellow = "\x1b[1;33m"
+	ansiBoldGreen  = "\x1b[1;32m"
+)
+
+// WriteText writes comparison as a human readable table, regressions are colored red when color is set
+func (comparison *Comparison) WriteText(w io.Writer, settings CompareSettings, color bool) error {
+	paint := func(c string) string {
+		if !color {
+			return ""
+		}
+		return c
+	}
+
+	buf := helpers.NewBuffer()
+	buf.WriteString(paint(ansiBoldBlue))
+	buf.WriteString(fmt.Sprintf("Errors: %s\nWarnings: %s\n\n", countDeltaString(&comparison.Errors), countDeltaString(&comparison.Warnings)))
+	buf.WriteString(paint(ansiReset))
+
+	actWidth, lblWidth := 6, 5
+	for _, action := range comparison.Actions {
+		actWidth = maxInt(actWidth, len(action.Action))
+		lblWidth = maxInt(lblWidth, len(action.Label))
+	}
+
+	tbl := tabular.New()
+	tbl.Col("act", "Action", actWidth)
+	tbl.Col("lbl", "Label", lblWidth)
+	tbl.ColRJ("base", "Baseline "+settings.Metric, 12)
+	tbl.ColRJ("cand", "Candidate "+settings.Metric, 13)
+	tbl.ColRJ("delta", "Delta", 9)
+	tbl.ColRJ("errbase", "Err% base", 9)
+	tbl.ColRJ("errcand", "Err% cand", 9)
+	tbl.ColRJ("recv", "Recv delta", 10)
+	tbl.Col("note", "Note", 10)
+
+	table := tbl.Parse("*")
+	buf.WriteString(paint(ansiBoldBlue))
+	buf.WriteString(table.Header)
+	buf.WriteString("\n")
+	buf.WriteString(table.SubHeader)
+	buf.WriteString("\n")
+	buf.WriteString(paint(ansiReset))
+
+	for i := range comparison.Actions {
+		action := &comparison.Actions[i]
+		resp := action.metric(settings.Metric)
+		note := ""
+		rowColor := ansiBoldBlue
+		switch {
+		case action.Missing != "":
+			note = "missing in " + action.Missing
+			rowColor = ansiBoldYellow
+		case action.Regression:
+			note = "REGRESSION"
+			rowColor = ansiBoldRed
+		}
+		buf.WriteString(paint(rowColor))
+		buf.WriteString(fmt.Sprintf(table.Format, action.Action, action.Label,
+			durationString(resp.Baseline), durationString(resp.Candidate), percentString(resp),
+			fmt.Sprintf("%.2f", action.ErrorRate.Baseline), fmt.Sprintf("%.2f", action.ErrorRate.Candidate),
+			percentString(&action.RecvPerAction), note))
+		buf.WriteString(paint(ansiReset))
+	}
+
+	buf.WriteString("\n")
+	if comparison.Regressions > 0 {
+		buf.WriteString(paint(ansiBoldRed))
+		buf.WriteString(fmt.Sprintf("%d regression(s) found\n", comparison.Regressions))
+	} else {
+		buf.WriteString(paint(ansiBoldBlue))
+		buf.WriteString("No regressions found\n")
+	}
+	buf.WriteString(paint(ansiReset))
+
+	if buf.Error != nil {
+		return errors.WithStack(buf.Error)
+	}
+	buf.WriteTo(w)
+	return errors.WithStack(buf.Error)
+}
+
+func countDeltaString(delta *MetricDelta) string {
+	return fmt.Sprintf("%.0f -> %.0f (%+.0f)", delta.Baseline, delta.Candidate, delta.Delta)
+}
+
+func percentString(delta *MetricDelta) string {
+	if delta.Baseline == 0 {
+		if delta.Candidate == 0 {
+			return "0.0%"
+		}
+		return "new"
+	}
+	return fmt.Sprintf("%+.1f%%", delta.DeltaPercent)
+}
+
+func durationString(ns float64) string {
+	return time.Duration(ns).Round(time.Microsecond).String()
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
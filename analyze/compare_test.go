@@ -0,0 +1,136 @@
+package analyze
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/qlik-oss/gopherciser/statistics"
+)
+
+func testSummary(t *testing.T, openTime, sheetTime time.Duration, sheetFailed int) *statistics.SummaryFile {
+	t.Helper()
+	counters := &statistics.ExecutionCounters{StatisticsCollector: statistics.NewCollector()}
+	if err := counters.StatisticsCollector.SetLevel(statistics.StatsLevelOn); err != nil {
+		t.Fatal(err)
+	}
+
+	// same action in two apps should be merged
+	for _, app := range []string{"app1", "app2"} {
+		stats := counters.StatisticsCollector.GetOrAddActionStats("openapp", "", app)
+		for i := 0; i < 50; i++ {
+			stats.RespAvg.AddSample(uint64(openTime))
+			stats.Received.Add(1000)
+		}
+	}
+	stats := counters.StatisticsCollector.GetOrAddActionStats("changesheet", "sheet", "app1")
+	for i := 0; i < 100-sheetFailed; i++ {
+		stats.RespAvg.AddSample(uint64(sheetTime))
+	}
+	stats.Failed.Add(uint64(sheetFailed))
+
+	return statistics.NewSummaryFile(time.Minute, counters)
+}
+
+func TestCompare(t *testing.T) {
+	baseline := testSummary(t, time.Second, 100*time.Millisecond, 0)
+	candidate := testSummary(t, 1050*time.Millisecond, 150*time.Millisecond, 5)
+
+	comparison, err := Compare(baseline, candidate, DefaultCompareSettings())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(comparison.Actions) != 2 {
+		t.Fatalf("expected 2 compared actions, got<%d>", len(comparison.Actions))
+	}
+
+	// sorted by action name
+	sheet, open := comparison.Actions[0], comparison.Actions[1]
+	if open.Action != "openapp" || sheet.Action != "changesheet" {
+		t.Fatalf("unexpected action order<%s,%s>", comparison.Actions[0].Action, comparison.Actions[1].Action)
+	}
+	if open.BaselineSamples != 100 {
+		t.Errorf("expected openapp merged over apps to have 100 samples, got<%d>", open.BaselineSamples)
+	}
+	if open.Regression {
+		t.Errorf("openapp 5%% slower should be within tolerance: %+v", open.P95)
+	}
+	if !sheet.Regression || !sheet.P95.Regression || !sheet.ErrorRate.Regression {
+		t.Errorf("changesheet expected response time and error rate regression: %+v", sheet)
+	}
+	if comparison.Regressions != 1 {
+		t.Errorf("expected 1 regression, got<%d>", comparison.Regressions)
+	}
+
+	buf := bytes.NewBuffer(nil)
+	if err := comparison.WriteText(buf, DefaultCompareSettings(), false); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "REGRESSION") || strings.Contains(buf.String(), "\x1b") {
+		t.Errorf("unexpected text output:\n%s", buf.String())
+	}
+
+	settings := DefaultCompareSettings()
+	settings.Metric = "p42"
+	if _, err := Compare(baseline, candidate, settings); err == nil {
+		t.Error("expected error for unknown metric")
+	}
+}
+
+func TestLoadSummary(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		fileName := filepath.Join(dir, name)
+		if err := os.WriteFile(fileName, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return fileName
+	}
+
+	summaryFileName := filepath.Join(dir, "summary.json")
+	if err := testSummary(t, time.Second, 100*time.Millisecond, 0).Write(summaryFileName); err != nil {
+		t.Fatal(err)
+	}
+	summaryFile, err := LoadSummary(summaryFileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(summaryFile.Actions) != 3 {
+		t.Errorf("expected 3 actions in summary, got<%d>", len(summaryFile.Actions))
+	}
+
+	logFileName := write("log.tsv", "time\tlevel\tAction\tLabel\tActionId\tResponseTime\tSuccess\tUser\tThread\tSession\n"+
+		"2023-01-01T10:00:00Z\tresult\topenapp\tOpen\t1\t1500000000\ttrue\tuser1\t1\t1\n")
+	summaryFile, err = LoadSummary(logFileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(summaryFile.Actions) != 1 || summaryFile.Actions[0].Successful != 1 {
+		t.Errorf("unexpected actions<%+v> from log", summaryFile.Actions)
+	}
+
+	jsonLogFileName := write("log.json", `{"time":"2023-01-01T10:00:00Z","level":"result","Action":"openapp","ResponseTime":1500000000,"Success":true}`+"\n")
+	if _, err := LoadSummary(jsonLogFileName); err != nil {
+		t.Error(err)
+	}
+
+	for name, content := range map[string]string{
+		// summary file of legacy format only has totals
+		"legacy.json": `[{"longTitle":"Total errors","shortTitle":"TotErrors","value":"0"}]`,
+		// neither summary file nor log
+		"broken.json": `[{"longTitle":`,
+		"notes.txt":   "some notes\nabout an execution\n",
+		"object.json": `{"some":"object"}`,
+		// logs without rows
+		"empty.tsv":  "time\tlevel\tAction\tLabel\n",
+		"empty.json": "",
+	} {
+		if _, err := LoadSummary(write(name, content)); err == nil {
+			t.Errorf("expected error loading %s", name)
+		}
+	}
+}
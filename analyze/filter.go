@@ -0,0 +1,208 @@
+package analyze
+
+import (
+	"container/heap"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/InVisionApp/tabular"
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/gopherciser/helpers"
+	"github.com/qlik-oss/gopherciser/logger"
+)
+
+type (
+	// TimeBound start or end of a time window, either an absolute time or an offset from start of log
+	TimeBound struct {
+		// Time absolute time, used when not zero
+		Time time.Time
+		// Offset from time of first row in log
+		Offset time.Duration
+		// set is true when bound has been defined
+		set bool
+	}
+
+	// Filter selects log rows to analyze, empty fields match all rows
+	Filter struct {
+		From TimeBound
+		To   TimeBound
+		// User matches authenticated user
+		User string
+		// App matches either app GUID or app name
+		App    string
+		Action string
+		Label  string
+
+		logStart time.Time
+	}
+
+	// SlowestActions keeps the action results with the longest response times
+	SlowestActions struct {
+		n    int
+		rows slowestHeap
+	}
+
+	slowestHeap []LogRow
+)
+
+// ParseTimeBound parses either an RFC3339 timestamp or a duration relative to start of log, e.g. "5m"
+func ParseTimeBound(s string) (TimeBound, error) {
+	if s == "" {
+		return TimeBound{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return TimeBound{Time: t, set: true}, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return TimeBound{}, errors.Errorf("time<%s> is neither a RFC3339 timestamp nor a duration", s)
+	}
+	return TimeBound{Offset: d, set: true}, nil
+}
+
+// IsSet returns true if time bound has been defined
+func (bound TimeBound) IsSet() bool {
+	return bound.set
+}
+
+// time of bound for log starting at start
+func (bound TimeBound) time(start time.Time) time.Time {
+	if !bound.Time.IsZero() {
+		return bound.Time
+	}
+	return start.Add(bound.Offset)
+}
+
+// Match returns true if row should be included in analysis. Rows are expected in log order, the first row with
+// a timestamp defines start of log for time bounds defined as offsets.
+func (filter *Filter) Match(row *LogRow) bool {
+	if filter == nil {
+		return true
+	}
+
+	if !row.Time.IsZero() {
+		if filter.logStart.IsZero() {
+			filter.logStart = row.Time
+		}
+		if filter.From.IsSet() && row.Time.Before(filter.From.time(filter.logStart)) {
+			return false
+		}
+		if filter.To.IsSet() && row.Time.After(filter.To.time(filter.logStart)) {
+			return false
+		}
+	}
+
+	if filter.User != "" && row.User != filter.User {
+		return false
+	}
+	if filter.App != "" && row.AppGUID != filter.App && row.AppName != filter.App {
+		return false
+	}
+	if filter.Action != "" && row.Action != filter.Action {
+		return false
+	}
+	if filter.Label != "" && row.Label != filter.Label {
+		return false
+	}
+	return true
+}
+
+// NewSlowestActions creates a SlowestActions keeping the n slowest action results
+func NewSlowestActions(n int) *SlowestActions {
+	return &SlowestActions{n: n}
+}
+
+// Add action result row, other rows are ignored
+func (slowest *SlowestActions) Add(row *LogRow) {
+	if slowest == nil || slowest.n < 1 || row.Level != logger.ResultLevel.String() {
+		return
+	}
+	if len(slowest.rows) < slowest.n {
+		heap.Push(&slowest.rows, *row)
+		return
+	}
+	if row.ResponseTime > slowest.rows[0].ResponseTime {
+		slowest.rows[0] = *row
+		heap.Fix(&slowest.rows, 0)
+	}
+}
+
+// Rows returns the slowest action results ordered by response time, slowest first
+func (slowest *SlowestActions) Rows() []LogRow {
+	if slowest == nil {
+		return nil
+	}
+	rows := make([]LogRow, len(slowest.rows))
+	copy(rows, slowest.rows)
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].ResponseTime > rows[j].ResponseTime
+	})
+	return rows
+}
+
+// WriteText writes the slowest action results as a table
+func (slowest *SlowestActions) WriteText(w io.Writer) error {
+	rows := slowest.Rows()
+
+	actWidth, lblWidth, appWidth, userWidth, sessWidth := 6, 5, 3, 4, 7
+	for i := range rows {
+		actWidth = maxInt(actWidth, len(rows[i].Action))
+		lblWidth = maxInt(lblWidth, len(rows[i].Label))
+		appWidth = maxInt(appWidth, len(rows[i].AppName))
+		userWidth = maxInt(userWidth, len(rows[i].User))
+		sessWidth = maxInt(sessWidth, len(rows[i].SessionName))
+	}
+
+	tbl := tabular.New()
+	tbl.Col("time", "Time", 30)
+	tbl.Col("act", "Action", actWidth)
+	tbl.Col("lbl", "Label", lblWidth)
+	tbl.Col("app", "App", appWidth)
+	tbl.Col("user", "User", userWidth)
+	tbl.ColRJ("thread", "Thread", 6)
+	tbl.ColRJ("sess", "Session", 7)
+	tbl.Col("sessname", "Session name", maxInt(sessWidth, 12))
+	tbl.ColRJ("id", "ActionId", 8)
+	tbl.ColRJ("resp", "Response time", 13)
+	tbl.Col("ok", "Success", 7)
+
+	table := tbl.Parse("*")
+	buf := helpers.NewBuffer()
+	buf.WriteString(fmt.Sprintf("Slowest %d actions:\n", len(rows)))
+	buf.WriteString(table.Header)
+	buf.WriteString("\n")
+	buf.WriteString(table.SubHeader)
+	buf.WriteString("\n")
+	for i := range rows {
+		row := &rows[i]
+		buf.WriteString(fmt.Sprintf(table.Format, row.TimeString, row.Action, row.Label, row.AppName, row.User,
+			strconv.FormatUint(row.Thread, 10), strconv.FormatUint(row.Session, 10), row.SessionName,
+			strconv.FormatUint(row.ActionID, 10), time.Duration(row.ResponseTime).String(),
+			strconv.FormatBool(row.Success)))
+	}
+
+	if buf.Error != nil {
+		return errors.WithStack(buf.Error)
+	}
+	buf.WriteTo(w)
+	return errors.WithStack(buf.Error)
+}
+
+func (h slowestHeap) Len() int           { return len(h) }
+func (h slowestHeap) Less(i, j int) bool { return h[i].ResponseTime < h[j].ResponseTime }
+func (h slowestHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *slowestHeap) Push(x any) {
+	*h = append(*h, x.(LogRow))
+}
+
+func (h *slowestHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
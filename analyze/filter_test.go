@@ -0,0 +1,81 @@
+package analyze
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/qlik-oss/gopherciser/logger"
+)
+
+func TestFilter(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	from, err := ParseTimeBound("1m")
+	if err != nil {
+		t.Fatal(err)
+	}
+	to, err := ParseTimeBound(start.Add(3 * time.Minute).Format(time.RFC3339))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseTimeBound("yesterday"); err == nil {
+		t.Error("expected error parsing invalid time bound")
+	}
+
+	filter := &Filter{From: from, To: to, App: "app1"}
+	rows := []struct {
+		row      LogRow
+		expected bool
+	}{
+		{LogRow{Time: start, AppGUID: "app1"}, false},                      // ramp-up, defines start of log
+		{LogRow{Time: start.Add(2 * time.Minute), AppGUID: "app1"}, true},  // within window
+		{LogRow{Time: start.Add(2 * time.Minute), AppName: "app1"}, true},  // app matched by name
+		{LogRow{Time: start.Add(2 * time.Minute), AppGUID: "app2"}, false}, // other app
+		{LogRow{Time: start.Add(4 * time.Minute), AppGUID: "app1"}, false}, // after window
+		{LogRow{AppGUID: "app1"}, true},                                    // no timestamp
+	}
+	for i, test := range rows {
+		if match := filter.Match(&test.row); match != test.expected {
+			t.Errorf("row<%d> expected match<%v> got<%v>", i, test.expected, match)
+		}
+	}
+
+	var noFilter *Filter
+	if !noFilter.Match(&LogRow{Action: "openapp"}) {
+		t.Error("nil filter should match all rows")
+	}
+}
+
+func TestSlowestActions(t *testing.T) {
+	slowest := NewSlowestActions(3)
+	for i, resp := range []time.Duration{5, 1, 9, 3, 7, 2} {
+		slowest.Add(&LogRow{
+			Level:        logger.ResultLevel.String(),
+			Action:       "changesheet",
+			Session:      uint64(i + 1),
+			ResponseTime: int64(resp * time.Millisecond),
+			Success:      true,
+		})
+	}
+	// non-result rows are ignored
+	slowest.Add(&LogRow{Level: logger.ErrorLevel.String(), ResponseTime: int64(time.Hour)})
+
+	rows := slowest.Rows()
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 rows, got<%d>", len(rows))
+	}
+	for i, session := range []uint64{3, 5, 1} {
+		if rows[i].Session != session {
+			t.Errorf("row<%d> expected session<%d> got<%d>", i, session, rows[i].Session)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := slowest.WriteText(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "9ms") {
+		t.Errorf("expected slowest action in table:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,262 @@
+package analyze
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/gopherciser/logger"
+)
+
+type (
+	// LogRow one row of a gopherciser TSV or JSON log
+	LogRow struct {
+		Time         time.Time `json:"-"`
+		TimeString   string    `json:"time"`
+		Level        string    `json:"level"`
+		Action       string    `json:"Action"`
+		Label        string    `json:"Label"`
+		ActionID     uint64    `json:"ActionId"`
+		InfoType     string    `json:"InfoType"`
+		Message      string    `json:"message"`
+		Details      string    `json:"Details"`
+		Success      bool      `json:"Success"`
+		ResponseTime int64     `json:"ResponseTime"`
+		AppName      string    `json:"AppName"`
+		AppGUID      string    `json:"AppGUID"`
+		User         string    `json:"User"`
+		Thread       uint64    `json:"Thread"`
+		Session      uint64    `json:"Session"`
+		SessionName  string    `json:"SessionName"`
+		ObjectType   string    `json:"ObjectType"`
+		Warnings     uint64    `json:"Warnings"`
+		Errors       uint64    `json:"Errors"`
+		Stack        string    `json:"Stack"`
+		Sent         uint64    `json:"Sent"`
+		Received     uint64    `json:"Received"`
+		RequestsSent uint64    `json:"RequestsSent"`
+		// ErrorCategory, ErrorCode and Fingerprint classifies error rows
+		ErrorCategory string `json:"ErrorCategory"`
+		ErrorCode     string `json:"ErrorCode"`
+		Fingerprint   string `json:"Fingerprint"`
+	}
+
+	tsvFieldSetter func(row *LogRow, value string) error
+)
+
+// MaxLogRowSize max size of a log row, rows with e.g. stack traces and traffic logging can be long
+var MaxLogRowSize = 64 * 1024 * 1024
+
+// logDetectSize max size of the start of a file used to detect if the file is a log
+const logDetectSize = 1024 * 1024
+
+// knownLogFields fields written by gopherciser TSV logs
+var knownLogFields = func() map[string]struct{} {
+	fields := make(map[string]struct{}, len(logger.AllFields))
+	for _, field := range logger.AllFields {
+		fields[field] = struct{}{}
+	}
+	return fields
+}()
+
+var tsvFieldSetters = map[string]tsvFieldSetter{
+	logger.FieldTime: func(row *LogRow, v string) error {
+		row.TimeString = v
+		return nil
+	},
+	logger.FieldLevel:         func(row *LogRow, v string) error { row.Level = v; return nil },
+	logger.FieldAction:        func(row *LogRow, v string) error { row.Action = v; return nil },
+	logger.FieldLabel:         func(row *LogRow, v string) error { row.Label = v; return nil },
+	logger.FieldActionID:      func(row *LogRow, v string) error { return parseUint(v, &row.ActionID) },
+	logger.FieldInfoType:      func(row *LogRow, v string) error { row.InfoType = v; return nil },
+	logger.FieldMessage:       func(row *LogRow, v string) error { row.Message = v; return nil },
+	logger.FieldDetails:       func(row *LogRow, v string) error { row.Details = v; return nil },
+	logger.FieldAppName:       func(row *LogRow, v string) error { row.AppName = v; return nil },
+	logger.FieldAppGUID:       func(row *LogRow, v string) error { row.AppGUID = v; return nil },
+	logger.FieldAuthUser:      func(row *LogRow, v string) error { row.User = v; return nil },
+	logger.FieldSessionName:   func(row *LogRow, v string) error { row.SessionName = v; return nil },
+	logger.FieldObjectType:    func(row *LogRow, v string) error { row.ObjectType = v; return nil },
+	logger.FieldStack:         func(row *LogRow, v string) error { row.Stack = v; return nil },
+	logger.FieldErrorCategory: func(row *LogRow, v string) error { row.ErrorCategory = v; return nil },
+	logger.FieldErrorCode:     func(row *LogRow, v string) error { row.ErrorCode = v; return nil },
+	logger.FieldFingerprint:   func(row *LogRow, v string) error { row.Fingerprint = v; return nil },
+	logger.FieldThread:        func(row *LogRow, v string) error { return parseUint(v, &row.Thread) },
+	logger.FieldSession:       func(row *LogRow, v string) error { return parseUint(v, &row.Session) },
+	logger.FieldWarnings:      func(row *LogRow, v string) error { return parseUint(v, &row.Warnings) },
+	logger.FieldErrors:        func(row *LogRow, v string) error { return parseUint(v, &row.Errors) },
+	logger.FieldSent:          func(row *LogRow, v string) error { return parseUint(v, &row.Sent) },
+	logger.FieldReceived:      func(row *LogRow, v string) error { return parseUint(v, &row.Received) },
+	logger.FieldRequestsSent: func(row *LogRow, v string) error {
+		return parseUint(v, &row.RequestsSent)
+	},
+	logger.FieldResponseTime: func(row *LogRow, v string) error {
+		if v == "" {
+			return nil
+		}
+		var err error
+		row.ResponseTime, err = strconv.ParseInt(v, 10, 64)
+		return err
+	},
+	logger.FieldSuccess: func(row *LogRow, v string) error {
+		if v == "" {
+			return nil
+		}
+		var err error
+		row.Success, err = strconv.ParseBool(v)
+		return err
+	},
+}
+
+func parseUint(v string, dst *uint64) error {
+	if v == "" {
+		return nil
+	}
+	var err error
+	*dst, err = strconv.ParseUint(v, 10, 64)
+	return err
+}
+
+// IsResult returns true if row is an action result
+func (row *LogRow) IsResult() bool {
+	return row.Level == logger.ResultLevel.String()
+}
+
+// ReadLog reads a TSV or JSON formatted gopherciser log and executes f for each row. The format is detected from
+// the first character of the log. The row is re-used between calls to f.
+func ReadLog(r io.Reader, f func(row *LogRow) error) error {
+	reader := bufio.NewReaderSize(r, 64*1024)
+	first, err := firstNonSpace(reader)
+	if err != nil {
+		if err == io.EOF {
+			return nil
+		}
+		return errors.WithStack(err)
+	}
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), MaxLogRowSize)
+
+	if first == '{' {
+		return errors.WithStack(readJSONLog(scanner, f))
+	}
+	return errors.WithStack(readTSVLog(scanner, f))
+}
+
+// isLogStart returns true if the first line of data is a TSV log header only containing known log fields, including
+// time and level, or a JSON log row with time and level
+func isLogStart(data []byte) bool {
+	line := strings.TrimLeft(string(data), " \t\r\n")
+	if i := strings.IndexByte(line, '\n'); i >= 0 {
+		line = line[:i]
+	}
+	line = strings.TrimRight(line, "\r")
+	if line == "" {
+		return false
+	}
+
+	if line[0] == '{' {
+		var row LogRow
+		if err := json.Unmarshal([]byte(line), &row); err != nil {
+			return false
+		}
+		return row.TimeString != "" && row.Level != ""
+	}
+
+	header := strings.Split(line, "\t")
+	var hasTime, hasLevel bool
+	for _, field := range header {
+		if _, ok := knownLogFields[field]; !ok {
+			return false
+		}
+		hasTime = hasTime || field == logger.FieldTime
+		hasLevel = hasLevel || field == logger.FieldLevel
+	}
+	return hasTime && hasLevel
+}
+
+func firstNonSpace(reader *bufio.Reader) (byte, error) {
+	for {
+		b, err := reader.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		switch b {
+		case ' ', '\t', '\r', '\n':
+			continue
+		}
+		return b, reader.UnreadByte()
+	}
+}
+
+func readJSONLog(scanner *bufio.Scanner, f func(row *LogRow) error) error {
+	var row LogRow
+	line := 0
+	for scanner.Scan() {
+		line++
+		raw := scanner.Bytes()
+		if len(strings.TrimSpace(string(raw))) == 0 {
+			continue
+		}
+		row = LogRow{}
+		if err := json.Unmarshal(raw, &row); err != nil {
+			return errors.Wrapf(err, "failed to parse JSON log row<%d>", line)
+		}
+		if err := row.parseTime(); err != nil {
+			return errors.Wrapf(err, "failed to parse time of JSON log row<%d>", line)
+		}
+		if err := f(&row); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+func readTSVLog(scanner *bufio.Scanner, f func(row *LogRow) error) error {
+	if !scanner.Scan() {
+		return scanner.Err()
+	}
+	header := strings.Split(strings.TrimRight(scanner.Text(), "\r"), "\t")
+	setters := make([]tsvFieldSetter, len(header))
+	for i, field := range header {
+		setters[i] = tsvFieldSetters[field] // unknown fields are ignored
+	}
+
+	var row LogRow
+	line := 1
+	for scanner.Scan() {
+		line++
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if text == "" {
+			continue
+		}
+		row = LogRow{}
+		for i, value := range strings.Split(text, "\t") {
+			if i >= len(setters) || setters[i] == nil {
+				continue
+			}
+			if err := setters[i](&row, value); err != nil {
+				return errors.Wrapf(err, "failed to parse field<%s> of TSV log row<%d>", header[i], line)
+			}
+		}
+		if err := row.parseTime(); err != nil {
+			return errors.Wrapf(err, "failed to parse time of TSV log row<%d>", line)
+		}
+		if err := f(&row); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+func (row *LogRow) parseTime() error {
+	if row.TimeString == "" {
+		return nil
+	}
+	var err error
+	row.Time, err = time.Parse(time.RFC3339Nano, row.TimeString)
+	return err
+}
@@ -0,0 +1,72 @@
+package analyze
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReadTSVLog(t *testing.T) {
+	log := "time\tlevel\tAction\tLabel\tActionId\tResponseTime\tSuccess\tUser\tThread\tSession\tSent\tReceived\tRequestsSent\tmessage\n" +
+		"2023-01-01T10:00:00Z\tresult\topenapp\tOpen\t1\t1500000000\ttrue\tuser1\t1\t1\t100\t2000\t3\t\n" +
+		"2023-01-01T10:00:02Z\terror\topenapp\tOpen\t1\t\t\tuser1\t1\t1\t\t\t\tsomething failed\n" +
+		"2023-01-01T10:00:05Z\tresult\tchangesheet\t\t2\t200000000\tfalse\tuser1\t1\t1\t10\t20\t1\t\n"
+
+	var rows []LogRow
+	if err := ReadLog(strings.NewReader(log), func(row *LogRow) error {
+		rows = append(rows, *row)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 rows, got<%d>", len(rows))
+	}
+	if rows[0].Action != "openapp" || !rows[0].Success || rows[0].ResponseTime != 1500000000 || rows[0].Received != 2000 {
+		t.Errorf("unexpected first row<%+v>", rows[0])
+	}
+	if !rows[0].IsResult() || rows[1].IsResult() {
+		t.Error("unexpected result row detection")
+	}
+	if rows[1].Message != "something failed" {
+		t.Errorf("unexpected message<%s>", rows[1].Message)
+	}
+
+	agg := NewAggregator()
+	for i := range rows {
+		agg.Add(&rows[i])
+	}
+	if agg.Duration().Seconds() != 5 {
+		t.Errorf("unexpected duration<%v>", agg.Duration())
+	}
+	if agg.Counters.Errors.Current() != 1 || agg.Counters.Users.Current() != 1 || agg.Counters.Requests.Current() != 4 {
+		t.Errorf("unexpected counters errors<%d> users<%d> requests<%d>", agg.Counters.Errors.Current(),
+			agg.Counters.Users.Current(), agg.Counters.Requests.Current())
+	}
+	if agg.Counters.StatisticsCollector.OpenedApps() != 1 {
+		t.Errorf("expected 1 opened app, got<%d>", agg.Counters.StatisticsCollector.OpenedApps())
+	}
+}
+
+func TestReadJSONLog(t *testing.T) {
+	log := `{"time":"2023-01-01T10:00:00Z","level":"result","Action":"openapp","Label":"Open","ActionId":1,"ResponseTime":1500000000,"Success":true,"User":"user1"}
+{"time":"2023-01-01T10:00:01.5Z","level":"warning","message":"slow"}
+`
+	var rows []LogRow
+	if err := ReadLog(strings.NewReader(log), func(row *LogRow) error {
+		rows = append(rows, *row)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got<%d>", len(rows))
+	}
+	if rows[0].Label != "Open" || !rows[0].Success || rows[0].Time.IsZero() {
+		t.Errorf("unexpected first row<%+v>", rows[0])
+	}
+	if rows[1].Level != "warning" || rows[1].Message != "slow" {
+		t.Errorf("unexpected second row<%+v>", rows[1])
+	}
+}
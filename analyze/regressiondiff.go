@@ -0,0 +1,424 @@
+package analyze
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-json"
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/gopherciser/helpers"
+	"github.com/qlik-oss/gopherciser/logger"
+)
+
+type (
+	// RegressionLog data read from a regression log written by logger.RegressionLogger
+	RegressionLog struct {
+		// Header key and values written at start of log, e.g. VERSION
+		Header map[string]string
+		// Filters of data to compare, keys prefixed with "+" are compared and keys prefixed with "-" are ignored
+		Filters []string
+		// Entries in log order
+		Entries []RegressionEntry
+	}
+
+	// RegressionEntry data of an object logged after an action
+	RegressionEntry struct {
+		ID   string
+		Meta map[string]interface{}
+		Data interface{}
+	}
+
+	// RegressionValueDiff a value differing between baseline and candidate
+	RegressionValueDiff struct {
+		Path string `json:"path"`
+		// Kind is one of changed, added (only in candidate) or removed (only in baseline)
+		Kind      string      `json:"kind"`
+		Baseline  interface{} `json:"baseline,omitempty"`
+		Candidate interface{} `json:"candidate,omitempty"`
+	}
+
+	// RegressionObjectDiff differences of an object between baseline and candidate
+	RegressionObjectDiff struct {
+		ID         string `json:"id"`
+		ObjectID   string `json:"objectId"`
+		ObjectType string `json:"objectType"`
+		Action     string `json:"action"`
+		Label      string `json:"label,omitempty"`
+		// Missing is set to "baseline" or "candidate" if data ID only exists in one of the logs
+		Missing     string                `json:"missing,omitempty"`
+		Differences []RegressionValueDiff `json:"differences,omitempty"`
+	}
+
+	// RegressionDiff result of comparing two regression logs
+	RegressionDiff struct {
+		Compared  int                    `json:"compared"`
+		Equal     int                    `json:"equal"`
+		Different int                    `json:"different"`
+		Missing   int                    `json:"missing"`
+		Objects   []RegressionObjectDiff `json:"objects"`
+	}
+
+	regressionFilter struct {
+		include map[string]struct{}
+		exclude map[string]struct{}
+	}
+)
+
+const (
+	regressionHeaderEnd = "---"
+	regressionFilters   = "FILTERS"
+
+	diffKindChanged = "changed"
+	diffKindAdded   = "added"
+	diffKindRemoved = "removed"
+
+	maxDiffValueLength = 60
+)
+
+// LoadRegressionLog reads regression log from file, file may be gzip or zstd compressed
+func LoadRegressionLog(fileName string) (*RegressionLog, error) {
+	file, err := logger.OpenFile(fileName)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() { _ = file.Close() }()
+
+	regressionLog, err := ReadRegressionLog(file)
+	return regressionLog, errors.Wrapf(err, "failed to read regression log<%s>", fileName)
+}
+
+// ReadRegressionLog reads regression log written by logger.RegressionLogger
+func ReadRegressionLog(r io.Reader) (*RegressionLog, error) {
+	regressionLog := &RegressionLog{Header: make(map[string]string)}
+	reader := bufio.NewReaderSize(r, 64*1024)
+
+	inHeader := true
+	columnsRead := false
+	for lineNumber := 1; ; lineNumber++ {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, errors.WithStack(err)
+		}
+		line = bytes.TrimRight(line, "\r\n")
+
+		switch {
+		case len(line) == 0:
+		case inHeader:
+			if string(line) == regressionHeaderEnd {
+				inHeader = false
+				break
+			}
+			key, value, _ := strings.Cut(string(line), "\t")
+			regressionLog.Header[key] = value
+		case !columnsRead:
+			// ID, META and DATA column headers
+			columnsRead = true
+		default:
+			entry, entryErr := parseRegressionEntry(line)
+			if entryErr != nil {
+				return nil, errors.Wrapf(entryErr, "invalid regression log row<%d>", lineNumber)
+			}
+			regressionLog.Entries = append(regressionLog.Entries, entry)
+		}
+
+		if err == io.EOF {
+			break
+		}
+	}
+
+	if inHeader {
+		return nil, errors.New("regression log header not terminated")
+	}
+
+	if filters, ok := regressionLog.Header[regressionFilters]; ok {
+		if err := json.Unmarshal([]byte(filters), &regressionLog.Filters); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal regression log filters<%s>", filters)
+		}
+	}
+
+	return regressionLog, nil
+}
+
+func parseRegressionEntry(line []byte) (RegressionEntry, error) {
+	var entry RegressionEntry
+	columns := bytes.SplitN(line, []byte("\t"), 3)
+	if len(columns) != 3 {
+		return entry, errors.Errorf("expected 3 columns, got<%d>", len(columns))
+	}
+	if err := json.Unmarshal(columns[0], &entry.ID); err != nil {
+		return entry, errors.Wrap(err, "failed to unmarshal ID")
+	}
+	if err := json.Unmarshal(columns[1], &entry.Meta); err != nil {
+		return entry, errors.Wrap(err, "failed to unmarshal META")
+	}
+	if err := json.Unmarshal(columns[2], &entry.Data); err != nil {
+		return entry, errors.Wrap(err, "failed to unmarshal DATA")
+	}
+	return entry, nil
+}
+
+// DiffRegression matches entries of baseline and candidate by data ID and reports objects with differing data.
+// Data is filtered using filters of baseline log.
+func DiffRegression(baseline, candidate *RegressionLog) *RegressionDiff {
+	filter := newRegressionFilter(baseline.Filters)
+	diff := &RegressionDiff{}
+
+	candidateEntries := make(map[string]*RegressionEntry, len(candidate.Entries))
+	for i := range candidate.Entries {
+		candidateEntries[candidate.Entries[i].ID] = &candidate.Entries[i]
+	}
+
+	seen := make(map[string]struct{}, len(baseline.Entries))
+	for i := range baseline.Entries {
+		base := &baseline.Entries[i]
+		seen[base.ID] = struct{}{}
+		cand, ok := candidateEntries[base.ID]
+		if !ok {
+			objDiff := newRegressionObjectDiff(base)
+			objDiff.Missing = "candidate"
+			diff.Objects = append(diff.Objects, objDiff)
+			diff.Missing++
+			continue
+		}
+
+		diff.Compared++
+		objDiff := newRegressionObjectDiff(base)
+		diffValues("", filter.apply(base.Data), filter.apply(cand.Data), &objDiff.Differences)
+		if len(objDiff.Differences) == 0 {
+			diff.Equal++
+			continue
+		}
+		diff.Different++
+		diff.Objects = append(diff.Objects, objDiff)
+	}
+
+	for i := range candidate.Entries {
+		cand := &candidate.Entries[i]
+		if _, ok := seen[cand.ID]; ok {
+			continue
+		}
+		seen[cand.ID] = struct{}{}
+		objDiff := newRegressionObjectDiff(cand)
+		objDiff.Missing = "baseline"
+		diff.Objects = append(diff.Objects, objDiff)
+		diff.Missing++
+	}
+
+	return diff
+}
+
+func newRegressionObjectDiff(entry *RegressionEntry) RegressionObjectDiff {
+	metaString := func(key string) string {
+		switch v := entry.Meta[key].(type) {
+		case nil:
+			return ""
+		case string:
+			return v
+		default:
+			return fmt.Sprint(v)
+		}
+	}
+	return RegressionObjectDiff{
+		ID:         entry.ID,
+		ObjectID:   metaString("objectID"),
+		ObjectType: metaString("objectType"),
+		Action:     metaString("actionType"),
+		Label:      metaString("actionLabel"),
+	}
+}
+
+func newRegressionFilter(filters []string) *regressionFilter {
+	filter := &regressionFilter{
+		include: make(map[string]struct{}),
+		exclude: make(map[string]struct{}),
+	}
+	for _, f := range filters {
+		switch {
+		case strings.HasPrefix(f, "+"):
+			filter.include[f[1:]] = struct{}{}
+		case strings.HasPrefix(f, "-"):
+			filter.exclude[f[1:]] = struct{}{}
+		}
+	}
+	return filter
+}
+
+// apply filter to data, when include keys are defined only sub trees of included keys are kept. Excluded keys are
+// removed on all levels.
+func (filter *regressionFilter) apply(data interface{}) interface{} {
+	value, _ := filter.filterValue(data, len(filter.include) == 0)
+	return value
+}
+
+// filterValue returns filtered value and true if value should be kept
+func (filter *regressionFilter) filterValue(data interface{}, included bool) (interface{}, bool) {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		filtered := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			if _, ok := filter.exclude[key]; ok {
+				continue
+			}
+			_, includeKey := filter.include[key]
+			if value, keep := filter.filterValue(value, included || includeKey); keep {
+				filtered[key] = value
+			}
+		}
+		return filtered, included || len(filtered) > 0
+	case []interface{}:
+		filtered := make([]interface{}, len(v))
+		keep := included
+		for i, value := range v {
+			// keep position of elements to get correct index in diff path
+			if value, keepElement := filter.filterValue(value, included); keepElement {
+				filtered[i] = value
+				keep = true
+			}
+		}
+		return filtered, keep
+	default:
+		return v, included
+	}
+}
+
+// diffValues appends structural differences of baseline and candidate to diffs
+func diffValues(path string, baseline, candidate interface{}, diffs *[]RegressionValueDiff) {
+	switch base := baseline.(type) {
+	case map[string]interface{}:
+		cand, ok := candidate.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(base)+len(cand))
+		for key := range base {
+			keys = append(keys, key)
+		}
+		for key := range cand {
+			if _, ok := base[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			keyPath := key
+			if path != "" {
+				keyPath = path + "." + key
+			}
+			baseValue, inBase := base[key]
+			candValue, inCand := cand[key]
+			switch {
+			case !inCand:
+				*diffs = append(*diffs, RegressionValueDiff{Path: keyPath, Kind: diffKindRemoved, Baseline: baseValue})
+			case !inBase:
+				*diffs = append(*diffs, RegressionValueDiff{Path: keyPath, Kind: diffKindAdded, Candidate: candValue})
+			default:
+				diffValues(keyPath, baseValue, candValue, diffs)
+			}
+		}
+		return
+	case []interface{}:
+		cand, ok := candidate.([]interface{})
+		if !ok {
+			break
+		}
+		for i := 0; i < len(base) || i < len(cand); i++ {
+			indexPath := path + "[" + strconv.Itoa(i) + "]"
+			switch {
+			case i >= len(cand):
+				*diffs = append(*diffs, RegressionValueDiff{Path: indexPath, Kind: diffKindRemoved, Baseline: base[i]})
+			case i >= len(base):
+				*diffs = append(*diffs, RegressionValueDiff{Path: indexPath, Kind: diffKindAdded, Candidate: cand[i]})
+			default:
+				diffValues(indexPath, base[i], cand[i], diffs)
+			}
+		}
+		return
+	default:
+		if baseline == candidate {
+			return
+		}
+	}
+
+	*diffs = append(*diffs, RegressionValueDiff{Path: path, Kind: diffKindChanged, Baseline: baseline, Candidate: candidate})
+}
+
+// WriteText writes objects with differing data, at most maxDiffs differences are listed per object, 0 lists all
+// differences. Differences are colored when color is set.
+func (diff *RegressionDiff) WriteText(w io.Writer, maxDiffs int, color bool) error {
+	paint := func(c string) string {
+		if !color {
+			return ""
+		}
+		return c
+	}
+
+	buf := helpers.NewBuffer()
+	for i := range diff.Objects {
+		obj := &diff.Objects[i]
+		buf.WriteString(paint(ansiBoldBlue))
+		buf.WriteString(fmt.Sprintf("%s object<%s> type<%s> after action<%s>", obj.ID, obj.ObjectID, obj.ObjectType, obj.Action))
+		if obj.Label != "" {
+			buf.WriteString(fmt.Sprintf(" label<%s>", obj.Label))
+		}
+		buf.WriteString("\n")
+		buf.WriteString(paint(ansiReset))
+
+		if obj.Missing != "" {
+			buf.WriteString(paint(ansiBoldYellow))
+			buf.WriteString(fmt.Sprintf("  missing in %s\n", obj.Missing))
+			buf.WriteString(paint(ansiReset))
+			continue
+		}
+
+		for j, valueDiff := range obj.Differences {
+			if maxDiffs > 0 && j >= maxDiffs {
+				buf.WriteString(fmt.Sprintf("  ... %d more difference(s)\n", len(obj.Differences)-maxDiffs))
+				break
+			}
+			buf.WriteString(fmt.Sprintf("  %s:\n", valueDiff.Path))
+			if valueDiff.Kind != diffKindAdded {
+				buf.WriteString(paint(ansiBoldRed))
+				buf.WriteString(fmt.Sprintf("    - %s\n", diffValueString(valueDiff.Baseline)))
+				buf.WriteString(paint(ansiReset))
+			}
+			if valueDiff.Kind != diffKindRemoved {
+				buf.WriteString(paint(ansiBoldGreen))
+				buf.WriteString(fmt.Sprintf("    + %s\n", diffValueString(valueDiff.Candidate)))
+				buf.WriteString(paint(ansiReset))
+			}
+		}
+	}
+
+	if len(diff.Objects) > 0 {
+		buf.WriteString("\n")
+	}
+	summaryColor := ansiBoldBlue
+	if diff.Different > 0 || diff.Missing > 0 {
+		summaryColor = ansiBoldRed
+	}
+	buf.WriteString(paint(summaryColor))
+	buf.WriteString(fmt.Sprintf("%d object(s) compared, %d equal, %d different, %d missing\n", diff.Compared, diff.Equal, diff.Different, diff.Missing))
+	buf.WriteString(paint(ansiReset))
+
+	if buf.Error != nil {
+		return errors.WithStack(buf.Error)
+	}
+	buf.WriteTo(w)
+	return errors.WithStack(buf.Error)
+}
+
+func diffValueString(value interface{}) string {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	if len(raw) > maxDiffValueLength {
+		return string(raw[:maxDiffValueLength-3]) + "..."
+	}
+	return string(raw)
+}
@@ -0,0 +1,110 @@
+package analyze
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/qlik-oss/gopherciser/logger"
+)
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+func testRegressionLog(t *testing.T, cellText string, num float64, extraObject bool) *RegressionLog {
+	t.Helper()
+	var buf bytes.Buffer
+	regressionLogger := logger.NewRegressionLogger(nopWriteCloser{&buf}, logger.HeaderEntry{Key: "ID_FORMAT", Value: "sessionID.actionID.objectID"})
+
+	objects := []string{"obj1", "obj2"}
+	if extraObject {
+		objects = append(objects, "obj3")
+	}
+	for _, obj := range objects {
+		data := map[string]interface{}{
+			"hyperCube": map[string]interface{}{
+				"qDataPages": []interface{}{map[string]interface{}{
+					"qMatrix": []interface{}{[]interface{}{
+						map[string]interface{}{"qText": cellText, "qNum": num},
+					}},
+				}},
+				// not included in filters
+				"qSize": map[string]interface{}{"qcy": num},
+			},
+		}
+		if obj == "obj1" {
+			// obj1 always returns same data
+			data["hyperCube"] = map[string]interface{}{"qDataPages": []interface{}{}}
+		}
+		if err := regressionLogger.Log("1.2."+obj, data, map[string]interface{}{
+			"actionType": "changesheet",
+			"objectID":   obj,
+			"objectType": "table",
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	regressionLog, err := ReadRegressionLog(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return regressionLog
+}
+
+func TestReadRegressionLog(t *testing.T) {
+	regressionLog := testRegressionLog(t, "a", 1, false)
+	if regressionLog.Header["ID_FORMAT"] != "sessionID.actionID.objectID" {
+		t.Errorf("unexpected header<%v>", regressionLog.Header)
+	}
+	if len(regressionLog.Filters) == 0 {
+		t.Error("filters not read from header")
+	}
+	if len(regressionLog.Entries) != 2 || regressionLog.Entries[1].ID != "1.2.obj2" {
+		t.Fatalf("unexpected entries<%+v>", regressionLog.Entries)
+	}
+}
+
+func TestDiffRegression(t *testing.T) {
+	// qNum is excluded and qSize is not included by filters
+	diff := DiffRegression(testRegressionLog(t, "a", 1, false), testRegressionLog(t, "a", 2, false))
+	if diff.Compared != 2 || diff.Equal != 2 || len(diff.Objects) != 0 {
+		t.Errorf("expected filtered data to be equal, got<%+v>", diff)
+	}
+
+	diff = DiffRegression(testRegressionLog(t, "a", 1, false), testRegressionLog(t, "b", 1, true))
+	if diff.Compared != 2 || diff.Equal != 1 || diff.Different != 1 || diff.Missing != 1 {
+		t.Fatalf("unexpected diff counters<%+v>", diff)
+	}
+	if len(diff.Objects) != 2 {
+		t.Fatalf("expected 2 objects in diff, got<%d>", len(diff.Objects))
+	}
+
+	changed := diff.Objects[0]
+	if changed.ObjectID != "obj2" || changed.Action != "changesheet" || len(changed.Differences) != 1 {
+		t.Fatalf("unexpected changed object<%+v>", changed)
+	}
+	valueDiff := changed.Differences[0]
+	if valueDiff.Path != "hyperCube.qDataPages[0].qMatrix[0][0].qText" || valueDiff.Baseline != "a" || valueDiff.Candidate != "b" {
+		t.Errorf("unexpected difference<%+v>", valueDiff)
+	}
+
+	if missing := diff.Objects[1]; missing.ObjectID != "obj3" || missing.Missing != "baseline" {
+		t.Errorf("unexpected missing object<%+v>", missing)
+	}
+
+	var buf bytes.Buffer
+	if err := diff.WriteText(&buf, 10, false); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"hyperCube.qDataPages[0].qMatrix[0][0].qText", `- "a"`, `+ "b"`, "missing in baseline",
+		"2 object(s) compared, 1 equal, 1 different, 1 missing"} {
+		if !strings.Contains(buf.String(), expected) {
+			t.Errorf("text output missing<%s>:\n%s", expected, buf.String())
+		}
+	}
+}
@@ -0,0 +1,386 @@
+package analyze
+
+import (
+	"html/template"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/gopherciser/logger"
+	"github.com/qlik-oss/gopherciser/statistics"
+	"github.com/qlik-oss/gopherciser/version"
+)
+
+type (
+	// ReportBuilder collects log rows into a report
+	ReportBuilder struct {
+		// MaxTimelinePoints max amount of points in timeline charts
+		MaxTimelinePoints int
+		// MaxHotSpots max amount of entries in hot spot tables
+		MaxHotSpots int
+		// MaxIssues max amount of entries in error and warning tables
+		MaxIssues int
+
+		agg      *Aggregator
+		seconds  map[int64]*reportSecond
+		sessions map[uint64]*sessionSpan
+		issues   map[issueKey]*ReportIssue
+		apps     map[string]*hotSpotAggregate
+		objects  map[string]*hotSpotAggregate
+	}
+
+	reportSecond struct {
+		hist     *statistics.Histogram
+		failed   uint64
+		errors   uint64
+		warnings uint64
+	}
+
+	sessionSpan struct {
+		first, last time.Time
+	}
+
+	issueKey struct {
+		level, action, message string
+	}
+
+	hotSpotAggregate struct {
+		name   string
+		hist   *statistics.Histogram
+		failed uint64
+		errors uint64
+	}
+
+	// Report content of a HTML report
+	Report struct {
+		Title     string
+		Generated time.Time
+		Version   string
+		Start     time.Time
+		End       time.Time
+		Duration  time.Duration
+		Totals    []statistics.SummaryEntry
+		Actions   []ReportAction
+		Timeline  []TimelinePoint
+		Errors    []ReportIssue
+		Warnings  []ReportIssue
+		Apps      []HotSpot
+		Objects   []HotSpot
+	}
+
+	// TimelinePoint statistics of one interval of the timeline, response times are in nanoseconds
+	TimelinePoint struct {
+		Time           time.Time
+		Actions        uint64
+		Failed         uint64
+		Errors         uint64
+		Warnings       uint64
+		AvgResp        float64
+		P95            uint64
+		ActiveSessions uint64
+	}
+
+	// ReportAction statistics of an action and label merged over apps, response times are in nanoseconds
+	ReportAction struct {
+		Action      string
+		Label       string
+		Successful  uint64
+		Failed      uint64
+		ErrorRate   float64
+		AvgResp     float64
+		Percentiles statistics.Percentiles
+		Sent        uint64
+		Received    uint64
+	}
+
+	// ReportIssue error or warning grouped by action and message
+	ReportIssue struct {
+		Action        string
+		Message       string
+		Count         uint64
+		FirstSeen     time.Time
+		SampleDetails string
+		SampleStack   string
+	}
+
+	// HotSpot response time statistics of an app or object type, response times are in nanoseconds
+	HotSpot struct {
+		Name      string
+		Actions   uint64
+		Failed    uint64
+		Errors    uint64
+		TotalResp float64
+		AvgResp   float64
+		P95       uint64
+	}
+)
+
+const (
+	// DefaultMaxTimelinePoints default max amount of points in timeline charts
+	DefaultMaxTimelinePoints = 120
+	// DefaultMaxHotSpots default max amount of entries in hot spot tables
+	DefaultMaxHotSpots = 20
+	// DefaultMaxIssues default max amount of entries in error and warning tables
+	DefaultMaxIssues = 50
+
+	maxIssueMessageLength = 300
+)
+
+// NewReportBuilder creates a report builder with default limits
+func NewReportBuilder() *ReportBuilder {
+	return &ReportBuilder{
+		MaxTimelinePoints: DefaultMaxTimelinePoints,
+		MaxHotSpots:       DefaultMaxHotSpots,
+		MaxIssues:         DefaultMaxIssues,
+		agg:               NewAggregator(),
+		seconds:           make(map[int64]*reportSecond),
+		sessions:          make(map[uint64]*sessionSpan),
+		issues:            make(map[issueKey]*ReportIssue),
+		apps:              make(map[string]*hotSpotAggregate),
+		objects:           make(map[string]*hotSpotAggregate),
+	}
+}
+
+// Add log row to report
+func (builder *ReportBuilder) Add(row *LogRow) {
+	builder.agg.Add(row)
+	if row.Time.IsZero() {
+		return
+	}
+
+	if row.Session > 0 {
+		span, ok := builder.sessions[row.Session]
+		if !ok {
+			builder.sessions[row.Session] = &sessionSpan{first: row.Time, last: row.Time}
+		} else {
+			if row.Time.Before(span.first) {
+				span.first = row.Time
+			}
+			if row.Time.After(span.last) {
+				span.last = row.Time
+			}
+		}
+	}
+
+	second := builder.second(row.Time)
+	switch row.Level {
+	case logger.ErrorLevel.String():
+		second.errors++
+		builder.addIssue(row)
+	case logger.WarningLevel.String():
+		second.warnings++
+		builder.addIssue(row)
+	case logger.ResultLevel.String():
+		if !row.Success {
+			second.failed++
+		} else {
+			second.hist.Add(uint64(row.ResponseTime))
+		}
+		appName := row.AppName
+		if appName == "" {
+			appName = row.AppGUID
+		}
+		if appName != "" {
+			addHotSpot(builder.apps, appName, row)
+		}
+		if row.ObjectType != "" {
+			addHotSpot(builder.objects, row.ObjectType, row)
+		}
+	}
+}
+
+func (builder *ReportBuilder) second(t time.Time) *reportSecond {
+	key := t.Unix()
+	second, ok := builder.seconds[key]
+	if !ok {
+		second = &reportSecond{hist: statistics.NewHistogram()}
+		builder.seconds[key] = second
+	}
+	return second
+}
+
+func (builder *ReportBuilder) addIssue(row *LogRow) {
+	message := row.Message
+	if len(message) > maxIssueMessageLength {
+		message = message[:maxIssueMessageLength] + "..."
+	}
+	key := issueKey{level: row.Level, action: row.Action, message: message}
+	issue, ok := builder.issues[key]
+	if !ok {
+		issue = &ReportIssue{Action: row.Action, Message: message, FirstSeen: row.Time}
+		builder.issues[key] = issue
+	}
+	issue.Count++
+	if issue.SampleDetails == "" {
+		issue.SampleDetails = row.Details
+	}
+	if issue.SampleStack == "" {
+		issue.SampleStack = row.Stack
+	}
+}
+
+func addHotSpot(hotSpots map[string]*hotSpotAggregate, name string, row *LogRow) {
+	hotSpot, ok := hotSpots[name]
+	if !ok {
+		hotSpot = &hotSpotAggregate{name: name, hist: statistics.NewHistogram()}
+		hotSpots[name] = hotSpot
+	}
+	if row.Success {
+		hotSpot.hist.Add(uint64(row.ResponseTime))
+	} else {
+		hotSpot.failed++
+	}
+	hotSpot.errors += row.Errors
+}
+
+// Build report from rows added
+func (builder *ReportBuilder) Build(title string) *Report {
+	summaryFile := statistics.NewSummaryFile(builder.agg.Duration(), builder.agg.Counters)
+
+	report := &Report{
+		Title:     title,
+		Generated: time.Now(),
+		Version:   version.Version,
+		Start:     builder.agg.Start,
+		End:       builder.agg.End,
+		Duration:  builder.agg.Duration(),
+		Totals:    summaryFile.Totals,
+		Actions:   reportActions(summaryFile),
+		Timeline:  builder.timeline(),
+		Apps:      hotSpots(builder.apps, builder.MaxHotSpots),
+		Objects:   hotSpots(builder.objects, builder.MaxHotSpots),
+	}
+	report.Errors, report.Warnings = builder.sortedIssues()
+	return report
+}
+
+// reportActions merges action statistics over apps sorted by action and label
+func reportActions(summaryFile *statistics.SummaryFile) []ReportAction {
+	merged := mergeActions(summaryFile)
+	actions := make([]ReportAction, 0, len(merged))
+	for _, agg := range merged {
+		actions = append(actions, ReportAction{
+			Action:      agg.action,
+			Label:       agg.label,
+			Successful:  agg.successful,
+			Failed:      agg.failed,
+			ErrorRate:   agg.errorRate(),
+			AvgResp:     agg.hist.Mean(),
+			Percentiles: agg.hist.Percentiles(),
+			Sent:        agg.sent,
+			Received:    agg.received,
+		})
+	}
+	sort.Slice(actions, func(i, j int) bool {
+		if actions[i].Action != actions[j].Action {
+			return actions[i].Action < actions[j].Action
+		}
+		return actions[i].Label < actions[j].Label
+	})
+	return actions
+}
+
+// timeline merges seconds into at most MaxTimelinePoints points
+func (builder *ReportBuilder) timeline() []TimelinePoint {
+	if builder.agg.Start.IsZero() {
+		return nil
+	}
+	start, end := builder.agg.Start.Unix(), builder.agg.End.Unix()
+	maxPoints := int64(builder.MaxTimelinePoints)
+	if maxPoints < 1 {
+		maxPoints = DefaultMaxTimelinePoints
+	}
+	step := (end - start + maxPoints) / maxPoints
+	if step < 1 {
+		step = 1
+	}
+
+	timeline := make([]TimelinePoint, 0, (end-start)/step+1)
+	for bucketStart := start; bucketStart <= end; bucketStart += step {
+		hist := statistics.NewHistogram()
+		point := TimelinePoint{Time: time.Unix(bucketStart, 0)}
+		for sec := bucketStart; sec < bucketStart+step; sec++ {
+			second, ok := builder.seconds[sec]
+			if !ok {
+				continue
+			}
+			hist.Merge(second.hist)
+			point.Failed += second.failed
+			point.Errors += second.errors
+			point.Warnings += second.warnings
+		}
+		point.Actions = hist.Count() + point.Failed
+		point.AvgResp = hist.Mean()
+		point.P95 = hist.Percentile(95)
+
+		bucketEnd := time.Unix(bucketStart+step, 0)
+		for _, span := range builder.sessions {
+			if span.first.Before(bucketEnd) && !span.last.Before(point.Time) {
+				point.ActiveSessions++
+			}
+		}
+		timeline = append(timeline, point)
+	}
+	return timeline
+}
+
+func (builder *ReportBuilder) sortedIssues() ([]ReportIssue, []ReportIssue) {
+	var errs, warnings []ReportIssue
+	for key, issue := range builder.issues {
+		if key.level == logger.ErrorLevel.String() {
+			errs = append(errs, *issue)
+		} else {
+			warnings = append(warnings, *issue)
+		}
+	}
+	return limitIssues(errs, builder.MaxIssues), limitIssues(warnings, builder.MaxIssues)
+}
+
+func limitIssues(issues []ReportIssue, max int) []ReportIssue {
+	sort.Slice(issues, func(i, j int) bool {
+		if issues[i].Count != issues[j].Count {
+			return issues[i].Count > issues[j].Count
+		}
+		return issues[i].FirstSeen.Before(issues[j].FirstSeen)
+	})
+	if max > 0 && len(issues) > max {
+		return issues[:max]
+	}
+	return issues
+}
+
+// hotSpots sorted by total response time
+func hotSpots(aggregates map[string]*hotSpotAggregate, max int) []HotSpot {
+	spots := make([]HotSpot, 0, len(aggregates))
+	for _, agg := range aggregates {
+		spots = append(spots, HotSpot{
+			Name:      agg.name,
+			Actions:   agg.hist.Count() + agg.failed,
+			Failed:    agg.failed,
+			Errors:    agg.errors,
+			TotalResp: agg.hist.Mean() * float64(agg.hist.Count()),
+			AvgResp:   agg.hist.Mean(),
+			P95:       agg.hist.Percentile(95),
+		})
+	}
+	sort.Slice(spots, func(i, j int) bool {
+		if spots[i].TotalResp != spots[j].TotalResp {
+			return spots[i].TotalResp > spots[j].TotalResp
+		}
+		return spots[i].Name < spots[j].Name
+	})
+	if max > 0 && len(spots) > max {
+		return spots[:max]
+	}
+	return spots
+}
+
+// WriteHTML writes report as a self-contained HTML page
+func (report *Report) WriteHTML(w io.Writer) error {
+	tmpl, err := template.New("report").Funcs(reportFuncMap).Parse(reportTemplate)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse report template")
+	}
+	return errors.Wrap(tmpl.Execute(w, report), "failed to execute report template")
+}
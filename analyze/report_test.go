@@ -0,0 +1,59 @@
+package analyze
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestReport(t *testing.T) {
+	log := "time\tlevel\tAction\tLabel\tActionId\tResponseTime\tSuccess\tAppName\tObjectType\tSession\tmessage\tStack\n" +
+		"2023-01-01T10:00:00Z\tresult\topenapp\t\t1\t1000000000\ttrue\tapp1\t\t1\t\t\n" +
+		"2023-01-01T10:00:01Z\tresult\tchangesheet\t\t2\t200000000\ttrue\tapp1\t\t1\t\t\n" +
+		"2023-01-01T10:00:02Z\tresult\tchangesheet\t\t2\t100000000\ttrue\tapp2\t\t2\t\t\n" +
+		"2023-01-01T10:00:03Z\terror\tselect\t\t3\t\t\tapp1\tbarchart\t1\tobject <not found>\tgoroutine 1\n" +
+		"2023-01-01T10:00:04Z\terror\tselect\t\t3\t\t\tapp1\tbarchart\t2\tobject <not found>\t\n" +
+		"2023-01-01T10:00:04Z\tresult\tselect\t\t3\t300000000\tfalse\tapp1\tbarchart\t2\t\t\n"
+
+	builder := NewReportBuilder()
+	if err := ReadLog(strings.NewReader(log), func(row *LogRow) error {
+		builder.Add(row)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	report := builder.Build("test <report>")
+	if len(report.Timeline) != 5 {
+		t.Errorf("expected 5 timeline points, got<%d>", len(report.Timeline))
+	}
+	if report.Timeline[2].ActiveSessions != 2 {
+		t.Errorf("expected 2 active sessions at 10:00:02, got<%d>", report.Timeline[2].ActiveSessions)
+	}
+	if len(report.Actions) != 3 || report.Actions[0].Action != "changesheet" || report.Actions[0].Successful != 2 {
+		t.Errorf("unexpected actions<%+v>", report.Actions)
+	}
+	if len(report.Errors) != 1 || report.Errors[0].Count != 2 || report.Errors[0].SampleStack != "goroutine 1" {
+		t.Errorf("unexpected errors<%+v>", report.Errors)
+	}
+	if len(report.Apps) != 2 || report.Apps[0].Name != "app1" {
+		t.Errorf("unexpected app hot spots<%+v>", report.Apps)
+	}
+	if len(report.Objects) != 1 || report.Objects[0].Failed != 1 {
+		t.Errorf("unexpected object hot spots<%+v>", report.Objects)
+	}
+
+	buf := bytes.NewBuffer(nil)
+	if err := report.WriteHTML(buf); err != nil {
+		t.Fatal(err)
+	}
+	html := buf.String()
+	for _, expected := range []string{"<svg", "test &lt;report&gt;", "object &lt;not found&gt;", "goroutine 1"} {
+		if !strings.Contains(html, expected) {
+			t.Errorf("report missing<%s>", expected)
+		}
+	}
+	if strings.Contains(html, "<script") || strings.Contains(html, "<link") {
+		t.Error("report should not reference external resources")
+	}
+}
@@ -0,0 +1,101 @@
+package analyze
+
+import (
+	"html/template"
+	"time"
+)
+
+var reportFuncMap = template.FuncMap{
+	"duration":  func(ns float64) string { return durationString(ns) },
+	"durationU": func(ns uint64) string { return durationString(float64(ns)) },
+	"time": func(t time.Time) string {
+		if t.IsZero() {
+			return "-"
+		}
+		return t.Format(time.RFC3339)
+	},
+	"percent": func(v float64) string { return formatCount(v) + "%" },
+}
+
+// reportTemplate self-contained HTML report, no external resources are referenced so the report can be shared as a
+// single file
+const reportTemplate = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Roboto, Helvetica, Arial, sans-serif; margin: 0 auto; max-width: 1200px; padding: 16px 24px; color: #222; }
+h1 { margin-bottom: 4px; }
+h2 { border-bottom: 1px solid #ccc; padding-bottom: 4px; margin-top: 32px; }
+.meta { color: #666; font-size: 0.9em; }
+table { border-collapse: collapse; width: 100%; font-size: 0.9em; }
+th, td { padding: 4px 8px; border-bottom: 1px solid #eee; text-align: left; vertical-align: top; }
+th { background: #f4f4f4; }
+td.num, th.num { text-align: right; font-variant-numeric: tabular-nums; }
+tr.failed td { color: #b00; }
+.totals { display: flex; flex-wrap: wrap; gap: 12px; }
+.total { background: #f4f6fa; border-radius: 4px; padding: 8px 12px; min-width: 120px; }
+.total .value { font-size: 1.4em; font-weight: bold; }
+.total .title { color: #666; font-size: 0.8em; }
+svg.chart { width: 100%; height: auto; }
+svg .grid { stroke: #e4e4e4; }
+svg text { font-size: 11px; fill: #555; }
+svg .ylabel { text-anchor: end; }
+svg .xlabel { text-anchor: middle; }
+details pre { white-space: pre-wrap; font-size: 0.85em; background: #f8f8f8; padding: 8px; }
+.empty { color: #888; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<div class="meta">Start: {{time .Start}} &middot; End: {{time .End}} &middot; Generated: {{time .Generated}}{{if .Version}} &middot; Gopherciser {{.Version}}{{end}}</div>
+
+<h2>Summary</h2>
+<div class="totals">{{range .Totals}}
+<div class="total"><div class="value">{{.Value}}</div><div class="title">{{.LongTitle}}</div></div>{{end}}
+</div>
+
+<h2>Response times</h2>
+<div class="meta">Successful actions, interval {{.Interval}}</div>
+{{.ResponseTimeChart}}
+
+<h2>Throughput</h2>
+<div class="meta">Actions per interval of {{.Interval}}</div>
+{{.ThroughputChart}}
+
+<h2>Concurrency</h2>
+{{.ConcurrencyChart}}
+
+<h2>Errors and warnings over time</h2>
+{{.IssuesChart}}
+
+<h2>Actions</h2>
+{{if .Actions}}<table>
+<tr><th>Action</th><th>Label</th><th class="num">Successful</th><th class="num">Failed</th><th class="num">Error rate</th><th class="num">Avg</th><th class="num">p50</th><th class="num">p90</th><th class="num">p95</th><th class="num">p99</th><th class="num">Max</th><th class="num">Sent</th><th class="num">Received</th></tr>
+{{range .Actions}}<tr{{if .Failed}} class="failed"{{end}}><td>{{.Action}}</td><td>{{.Label}}</td><td class="num">{{.Successful}}</td><td class="num">{{.Failed}}</td><td class="num">{{percent .ErrorRate}}</td><td class="num">{{duration .AvgResp}}</td><td class="num">{{durationU .Percentiles.P50}}</td><td class="num">{{durationU .Percentiles.P90}}</td><td class="num">{{durationU .Percentiles.P95}}</td><td class="num">{{durationU .Percentiles.P99}}</td><td class="num">{{durationU .Percentiles.Max}}</td><td class="num">{{.Sent}}</td><td class="num">{{.Received}}</td></tr>
+{{end}}</table>{{else}}<p class="empty">No action results</p>{{end}}
+
+<h2>Hot spots</h2>
+<h3>Apps</h3>
+{{template "hotspots" .Apps}}
+<h3>Object types</h3>
+{{template "hotspots" .Objects}}
+
+<h2>Errors</h2>
+{{template "issues" .Errors}}
+
+<h2>Warnings</h2>
+{{template "issues" .Warnings}}
+
+</body>
+</html>
+{{define "hotspots"}}{{if .}}<table>
+<tr><th>Name</th><th class="num">Actions</th><th class="num">Failed</th><th class="num">Errors</th><th class="num">Total time</th><th class="num">Avg</th><th class="num">p95</th></tr>
+{{range .}}<tr{{if .Failed}} class="failed"{{end}}><td>{{.Name}}</td><td class="num">{{.Actions}}</td><td class="num">{{.Failed}}</td><td class="num">{{.Errors}}</td><td class="num">{{duration .TotalResp}}</td><td class="num">{{duration .AvgResp}}</td><td class="num">{{durationU .P95}}</td></tr>
+{{end}}</table>{{else}}<p class="empty">No data</p>{{end}}{{end}}
+{{define "issues"}}{{if .}}<table>
+<tr><th class="num">Count</th><th>Action</th><th>Message</th><th>First seen</th></tr>
+{{range .}}<tr><td class="num">{{.Count}}</td><td>{{.Action}}</td><td>{{.Message}}{{if or .SampleDetails .SampleStack}}<details><summary>Sample</summary>{{if .SampleDetails}}<pre>{{.SampleDetails}}</pre>{{end}}{{if .SampleStack}}<pre>{{.SampleStack}}</pre>{{end}}</details>{{end}}</td><td>{{time .FirstSeen}}</td></tr>
+{{end}}</table>{{else}}<p class="empty">None</p>{{end}}{{end}}
+`
@@ -0,0 +1,177 @@
+package analyze
+
+import (
+	"fmt"
+	"html"
+	"html/template"
+	"math"
+	"strings"
+	"time"
+)
+
+type (
+	// chartSeries one line in a line chart
+	chartSeries struct {
+		Name   string
+		Color  string
+		Values []float64
+	}
+)
+
+const (
+	chartWidth     = 960
+	chartHeight    = 260
+	chartPadLeft   = 70
+	chartPadRight  = 20
+	chartPadTop    = 24
+	chartPadBottom = 30
+	chartYTicks    = 4
+	chartXLabels   = 6
+)
+
+// lineChart renders series as an inline SVG line chart, formatY formats values on the y axis
+func lineChart(times []time.Time, formatY func(v float64) string, series ...chartSeries) template.HTML {
+	if len(times) < 1 {
+		return template.HTML(`<p class="empty">No data</p>`)
+	}
+
+	maxY := 0.0
+	for _, s := range series {
+		for _, v := range s.Values {
+			maxY = math.Max(maxY, v)
+		}
+	}
+	maxY = niceCeil(maxY)
+
+	plotW := float64(chartWidth - chartPadLeft - chartPadRight)
+	plotH := float64(chartHeight - chartPadTop - chartPadBottom)
+	x := func(i int) float64 {
+		if len(times) < 2 {
+			return chartPadLeft + plotW/2
+		}
+		return chartPadLeft + plotW*float64(i)/float64(len(times)-1)
+	}
+	y := func(v float64) float64 {
+		return chartPadTop + plotH - plotH*v/maxY
+	}
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, `<svg class="chart" viewBox="0 0 %d %d" xmlns="http://www.w3.org/2000/svg">`, chartWidth, chartHeight)
+
+	// y axis grid and labels
+	for i := 0; i <= chartYTicks; i++ {
+		v := maxY * float64(i) / chartYTicks
+		yPos := y(v)
+		fmt.Fprintf(&buf, `<line class="grid" x1="%d" y1="%.1f" x2="%d" y2="%.1f"/>`, chartPadLeft, yPos, chartWidth-chartPadRight, yPos)
+		fmt.Fprintf(&buf, `<text class="ylabel" x="%d" y="%.1f">%s</text>`, chartPadLeft-6, yPos+4, html.EscapeString(formatY(v)))
+	}
+
+	// x axis labels
+	labels := chartXLabels
+	if labels > len(times) {
+		labels = len(times)
+	}
+	for i := 0; i < labels; i++ {
+		idx := 0
+		if labels > 1 {
+			idx = i * (len(times) - 1) / (labels - 1)
+		}
+		fmt.Fprintf(&buf, `<text class="xlabel" x="%.1f" y="%d">%s</text>`, x(idx), chartHeight-8, times[idx].Format("15:04:05"))
+	}
+
+	for seriesIdx, s := range series {
+		points := make([]string, 0, len(s.Values))
+		for i, v := range s.Values {
+			points = append(points, fmt.Sprintf("%.1f,%.1f", x(i), y(v)))
+		}
+		fmt.Fprintf(&buf, `<polyline fill="none" stroke="%s" stroke-width="2" points="%s"/>`, s.Color, strings.Join(points, " "))
+
+		// legend
+		legendX := chartPadLeft + 10 + seriesIdx*150
+		fmt.Fprintf(&buf, `<rect x="%d" y="6" width="12" height="12" fill="%s"/>`, legendX, s.Color)
+		fmt.Fprintf(&buf, `<text class="legend" x="%d" y="16">%s</text>`, legendX+16, html.EscapeString(s.Name))
+	}
+
+	buf.WriteString(`</svg>`)
+	return template.HTML(buf.String()) //nolint:gosec // all content generated or escaped above
+}
+
+// niceCeil rounds v up to 1, 2 or 5 times a power of 10
+func niceCeil(v float64) float64 {
+	if v <= 0 {
+		return 1
+	}
+	magnitude := math.Pow(10, math.Floor(math.Log10(v)))
+	for _, step := range []float64{1, 2, 5, 10} {
+		if v <= step*magnitude {
+			return step * magnitude
+		}
+	}
+	return 10 * magnitude
+}
+
+func formatCount(v float64) string {
+	if v == math.Trunc(v) {
+		return fmt.Sprintf("%.0f", v)
+	}
+	return fmt.Sprintf("%.1f", v)
+}
+
+func formatDurationAxis(v float64) string {
+	return durationString(v)
+}
+
+func timelineTimes(timeline []TimelinePoint) []time.Time {
+	times := make([]time.Time, 0, len(timeline))
+	for _, point := range timeline {
+		times = append(times, point.Time)
+	}
+	return times
+}
+
+func timelineValues(timeline []TimelinePoint, f func(point *TimelinePoint) float64) []float64 {
+	values := make([]float64, 0, len(timeline))
+	for i := range timeline {
+		values = append(values, f(&timeline[i]))
+	}
+	return values
+}
+
+// ResponseTimeChart average and 95th percentile response time over time
+func (report *Report) ResponseTimeChart() template.HTML {
+	return lineChart(timelineTimes(report.Timeline), formatDurationAxis,
+		chartSeries{Name: "Average", Color: "#1f77b4", Values: timelineValues(report.Timeline, func(p *TimelinePoint) float64 { return p.AvgResp })},
+		chartSeries{Name: "95th percentile", Color: "#ff7f0e", Values: timelineValues(report.Timeline, func(p *TimelinePoint) float64 { return float64(p.P95) })},
+	)
+}
+
+// ThroughputChart actions and failed actions over time
+func (report *Report) ThroughputChart() template.HTML {
+	return lineChart(timelineTimes(report.Timeline), formatCount,
+		chartSeries{Name: "Actions", Color: "#2ca02c", Values: timelineValues(report.Timeline, func(p *TimelinePoint) float64 { return float64(p.Actions) })},
+		chartSeries{Name: "Failed", Color: "#d62728", Values: timelineValues(report.Timeline, func(p *TimelinePoint) float64 { return float64(p.Failed) })},
+	)
+}
+
+// ConcurrencyChart active sessions over time
+func (report *Report) ConcurrencyChart() template.HTML {
+	return lineChart(timelineTimes(report.Timeline), formatCount,
+		chartSeries{Name: "Active sessions", Color: "#9467bd", Values: timelineValues(report.Timeline, func(p *TimelinePoint) float64 { return float64(p.ActiveSessions) })},
+	)
+}
+
+// IssuesChart errors and warnings over time
+func (report *Report) IssuesChart() template.HTML {
+	return lineChart(timelineTimes(report.Timeline), formatCount,
+		chartSeries{Name: "Errors", Color: "#d62728", Values: timelineValues(report.Timeline, func(p *TimelinePoint) float64 { return float64(p.Errors) })},
+		chartSeries{Name: "Warnings", Color: "#bcbd22", Values: timelineValues(report.Timeline, func(p *TimelinePoint) float64 { return float64(p.Warnings) })},
+	)
+}
+
+// Interval length of each timeline point
+func (report *Report) Interval() time.Duration {
+	if len(report.Timeline) < 2 {
+		return time.Second
+	}
+	return report.Timeline[1].Time.Sub(report.Timeline[0].Time)
+}
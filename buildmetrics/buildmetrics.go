@@ -5,6 +5,7 @@ package buildmetrics
 import (
 	"context"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/qlik-oss/gopherciser/metrics"
@@ -13,6 +14,10 @@ import (
 var (
 	enabled    bool
 	apiEnabled bool
+
+	// reporter set when reporting to a StatsD, InfluxDB or OTLP backend
+	reporter    metrics.Reporter
+	activeUsers atomic.Int64
 )
 
 // MetricEnabled returns whether metrics are enabled
@@ -40,17 +45,44 @@ func ReportApiResult(action, label, path, method string, responseCode int, durat
 		metrics.ApiCallDuration.WithLabelValues(actionlabel, path, method, resultString).Observe(duration.Seconds())
 		metrics.ApiCallDurationQuantile.WithLabelValues(actionlabel, path, method, resultString).Observe(duration.Seconds())
 	}
+	if reporter != nil {
+		reporter.Timing(metrics.MetricAPIRequestDuration, duration,
+			metrics.Tag{Key: "action", Value: getLabel(action, label)},
+			metrics.Tag{Key: "path", Value: path},
+			metrics.Tag{Key: "method", Value: method},
+			metrics.Tag{Key: "status_code", Value: strconv.Itoa(responseCode)},
+		)
+	}
+}
+
+// ReportEngineRequest reports the duration of an engine request for a specific engine method
+func ReportEngineRequest(method string, failed bool, duration time.Duration) {
+	if metricEnabled() {
+		metrics.GopherEngineRequestDuration.WithLabelValues(method).Observe(duration.Seconds())
+	}
+	if reporter != nil {
+		result := "success"
+		if failed {
+			result = "failure"
+		}
+		reporter.Timing(metrics.MetricEngineRequestDuration, duration, metrics.Tag{Key: "method", Value: method}, metrics.Tag{Key: "result", Value: result})
+	}
 }
 
 // ReportSuccess is invoked when a simulated user action is successfully completed.
 // This then updates Prometheus metrics correlating to this (ReponseTimes | Latency | success counter for an action)
-func ReportSuccess(action string, label string, time float64) {
+func ReportSuccess(action string, label string, responseTime float64) {
 	if metricEnabled() {
 		actionlabel := getLabel(action, label)
-		metrics.GopherResponseTimes.WithLabelValues(actionlabel).Observe(time)
-		metrics.GopherActionLatencyHist.WithLabelValues(actionlabel).Observe(time)
+		metrics.GopherResponseTimes.WithLabelValues(actionlabel).Observe(responseTime)
+		metrics.GopherActionLatencyHist.WithLabelValues(actionlabel).Observe(responseTime)
 		metrics.GopherActions.WithLabelValues("success", actionlabel).Inc()
 	}
+	if reporter != nil {
+		actionTag := metrics.Tag{Key: "action", Value: getLabel(action, label)}
+		reporter.Timing(metrics.MetricResponseTime, time.Duration(responseTime*float64(time.Second)), actionTag)
+		reporter.Count(metrics.MetricActions, 1, metrics.Tag{Key: "result", Value: "success"}, actionTag)
+	}
 }
 
 // ReportFailure is invoked when a simulated user action fails.
@@ -60,6 +92,9 @@ func ReportFailure(action string, label string) {
 		actionlabel := getLabel(action, label)
 		metrics.GopherActions.WithLabelValues("failure", actionlabel).Inc()
 	}
+	if reporter != nil {
+		reporter.Count(metrics.MetricActions, 1, metrics.Tag{Key: "result", Value: "failure"}, metrics.Tag{Key: "action", Value: getLabel(action, label)})
+	}
 }
 
 // ReportError is invoked when an error occurs in execution. A user action can in theory have many errors.
@@ -69,6 +104,9 @@ func ReportError(action string, label string) {
 		actionlabel := getLabel(action, label)
 		metrics.GopherErrors.WithLabelValues(actionlabel).Inc()
 	}
+	if reporter != nil {
+		reporter.Count(metrics.MetricErrors, 1, metrics.Tag{Key: "action", Value: getLabel(action, label)})
+	}
 }
 
 // ReportWarning is invoked when an warning occurs in execution. A user action can in theory have many warnings.
@@ -78,6 +116,9 @@ func ReportWarning(action string, label string) {
 		actionlabel := getLabel(action, label)
 		metrics.GopherWarnings.WithLabelValues(actionlabel).Inc()
 	}
+	if reporter != nil {
+		reporter.Count(metrics.MetricWarnings, 1, metrics.Tag{Key: "action", Value: getLabel(action, label)})
+	}
 }
 
 // AddUser is invoked when a new simulated user is added.
@@ -87,6 +128,10 @@ func AddUser() {
 		metrics.GopherUsersTotal.Inc()
 		metrics.GopherActiveUsers.Inc()
 	}
+	if reporter != nil {
+		reporter.Count(metrics.MetricUsers, 1)
+		reporter.Gauge(metrics.MetricActiveUsers, float64(activeUsers.Add(1)))
+	}
 }
 
 // RemoveUser is invoked when a new simulated user is added.
@@ -96,6 +141,9 @@ func RemoveUser() {
 	if metricEnabled() {
 		metrics.GopherActiveUsers.Dec()
 	}
+	if reporter != nil {
+		reporter.Gauge(metrics.MetricActiveUsers, float64(activeUsers.Add(-1)))
+	}
 }
 
 // PullMetrics is called once to setup and enable Prometheus pull metrics on a certain endpoint
@@ -118,3 +166,22 @@ func PushMetrics(ctx context.Context, metricsTarget, job string, groupingKeys, r
 	}
 	return nil
 }
+
+// StartReporter is called once to setup and enable reporting of metrics to a StatsD, InfluxDB or OTLP backend.
+// Returned function flushes remaining metrics and stops reporting.
+func StartReporter(ctx context.Context, settings metrics.ReporterSettings, registeredActions []string) (func(ctx context.Context) error, error) {
+	r, err := metrics.NewReporter(ctx, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize metrics
+	for _, action := range registeredActions {
+		r.Count(metrics.MetricActions, 0, metrics.Tag{Key: "result", Value: "success"}, metrics.Tag{Key: "action", Value: action})
+		r.Count(metrics.MetricActions, 0, metrics.Tag{Key: "result", Value: "failure"}, metrics.Tag{Key: "action", Value: action})
+	}
+	r.Gauge(metrics.MetricActiveUsers, 0)
+
+	reporter = r
+	return r.Close, nil
+}
@@ -26,6 +26,12 @@ func ReportApiResult(action, label, path, method string, responseCode int, durat
 	return
 }
 
+// ReportEngineRequest shall never report Prometheus metrics for WASM/JS builds as it is not supported nor wanted, hence "return"
+// Implemented as a way to dynamically import prometheus
+func ReportEngineRequest(method string, failed bool, duration time.Duration) {
+	return
+}
+
 // ReportSuccess shall never report Prometheus metrics for WASM/JS builds as it is not supported nor wanted, hence "return"
 // Implemented as a way to dynamically import prometheus
 func ReportSuccess(action string, label string, time float64) {
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/qlik-oss/gopherciser/analyze"
+	"github.com/qlik-oss/gopherciser/config"
+	"github.com/shiena/ansicolor"
+	"github.com/spf13/cobra"
+)
+
+var (
+	analyzeFrom        string
+	analyzeTo          string
+	analyzeFilter      analyze.Filter
+	analyzeSlowest     int
+	analyzeSummary     string
+	analyzeSummaryFile string
+
+	analyzeCmd = &cobra.Command{
+		Use:   "analyze <log> [log...]",
+		Short: "Analyze logs of an execution.",
+		Long: `Rebuild the execution summary from one or more TSV or JSON logs, optionally gzip or zstd compressed. Rows can be
+filtered by time window, user, app, action or label. Time windows are defined either as RFC3339 timestamps or as
+durations relative to the first row of the log, e.g. --from 5m excludes the first five minutes of the execution.`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			var summaryType config.SummaryType
+			summary, err := summaryType.GetEnumMap().Int(analyzeSummary)
+			if err != nil || config.SummaryType(summary) == config.SummaryTypeDefault {
+				_, _ = fmt.Fprintf(os.Stderr, "unknown summary type<%s>, expected one of none, simple, extended, full or file\n", analyzeSummary)
+				os.Exit(ExitCodeSummaryTypeError)
+			}
+
+			if analyzeFilter.From, err = analyze.ParseTimeBound(analyzeFrom); err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "failed to parse from: %v\n", err)
+				os.Exit(ExitCodeInvalidParameter)
+			}
+			if analyzeFilter.To, err = analyze.ParseTimeBound(analyzeTo); err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "failed to parse to: %v\n", err)
+				os.Exit(ExitCodeInvalidParameter)
+			}
+
+			agg := analyze.NewAggregator()
+			slowest := analyze.NewSlowestActions(analyzeSlowest)
+			for _, logFile := range args {
+				// each log has its own start time
+				filter := analyzeFilter
+				if err := readLogFile(logFile, func(row *analyze.LogRow) {
+					if !filter.Match(row) {
+						return
+					}
+					agg.Add(row)
+					slowest.Add(row)
+				}); err != nil {
+					_, _ = fmt.Fprintf(os.Stderr, "failed to read log<%s>: %v\n", logFile, err)
+					os.Exit(ExitCodeOsError)
+				}
+			}
+
+			config.PrintSummary(config.SummaryType(summary), agg.Duration(), agg.Counters, analyzeSummaryFile)
+
+			if analyzeSlowest > 0 {
+				fmt.Println()
+				if err := slowest.WriteText(ansicolor.NewAnsiColorWriter(os.Stdout)); err != nil {
+					_, _ = fmt.Fprintf(os.Stderr, "failed to write slowest actions: %v\n", err)
+					os.Exit(ExitCodeOsError)
+				}
+			}
+		},
+	}
+)
+
+func init() {
+	RootCmd.AddCommand(analyzeCmd)
+
+	analyzeCmd.Flags().StringVar(&analyzeFrom, "from", "", "Start of time window, RFC3339 timestamp or duration from start of log.")
+	analyzeCmd.Flags().StringVar(&analyzeTo, "to", "", "End of time window, RFC3339 timestamp or duration from start of log.")
+	analyzeCmd.Flags().StringVar(&analyzeFilter.User, "user", "", "Only analyze rows of user.")
+	analyzeCmd.Flags().StringVar(&analyzeFilter.App, "app", "", "Only analyze rows of app, matched by GUID or name.")
+	analyzeCmd.Flags().StringVar(&analyzeFilter.Action, "action", "", "Only analyze rows of action.")
+	analyzeCmd.Flags().StringVar(&analyzeFilter.Label, "label", "", "Only analyze rows of actions with label.")
+	analyzeCmd.Flags().IntVar(&analyzeSlowest, "slowest", 10, "Amount of slowest actions to list, 0 turns off list.")
+	analyzeCmd.Flags().StringVar(&analyzeSummary, "summary", "full", "Summary type, one of none, simple, extended, full or file.")
+	analyzeCmd.Flags().StringVar(&analyzeSummaryFile, "summaryfile", config.DefaultSummaryFilename, "Name of summary file written with summary type file.")
+}
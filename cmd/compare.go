@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/goccy/go-json"
+	"github.com/qlik-oss/gopherciser/analyze"
+	"github.com/qlik-oss/gopherciser/enummap"
+	"github.com/shiena/ansicolor"
+	"github.com/spf13/cobra"
+)
+
+type (
+	// CompareFormat output format of compare command
+	CompareFormat int
+)
+
+// CompareFormat enum
+const (
+	CompareFormatText CompareFormat = iota
+	CompareFormatJSON
+)
+
+var (
+	compareFormat   string
+	compareSettings = analyze.DefaultCompareSettings()
+	compareNoColor  bool
+
+	compareFormatEnum = enummap.NewEnumMapOrPanic(map[string]int{
+		"text": int(CompareFormatText),
+		"json": int(CompareFormatJSON),
+	})
+
+	compareCmd = &cobra.Command{
+		Use:     "compare <baseline> <candidate>",
+		Aliases: []string{"cmp"},
+		Short:   "Compare two executions.",
+		Long: `Compare a candidate execution with a baseline execution. Baseline and candidate can be either summary files
+(see summary type "file") or TSV or JSON logs. Per action response times, error rates and bytes per action are compared
+and exit code is set to non-zero if any regression outside the tolerances is found.`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			format, err := compareFormatEnum.Int(compareFormat)
+			if err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "unknown format<%s>, expected one of %v\n", compareFormat, compareFormatEnum.Keys())
+				os.Exit(ExitCodeMissingParameter)
+			}
+
+			baseline, err := analyze.LoadSummary(args[0])
+			if err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "failed to read baseline: %v\n", err)
+				os.Exit(ExitCodeOsError)
+			}
+			candidate, err := analyze.LoadSummary(args[1])
+			if err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "failed to read candidate: %v\n", err)
+				os.Exit(ExitCodeOsError)
+			}
+
+			comparison, err := analyze.Compare(baseline, candidate, compareSettings)
+			if err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "compare failed: %v\n", err)
+				os.Exit(ExitCodeMissingParameter)
+			}
+
+			switch CompareFormat(format) {
+			case CompareFormatJSON:
+				jsn, err := json.MarshalIndent(comparison, "", "  ")
+				if err != nil {
+					_, _ = fmt.Fprintf(os.Stderr, "failed to marshal comparison: %v\n", err)
+					os.Exit(ExitCodeExecutionError)
+				}
+				fmt.Println(string(jsn))
+			default:
+				if err := comparison.WriteText(ansicolor.NewAnsiColorWriter(os.Stdout), compareSettings, !compareNoColor); err != nil {
+					_, _ = fmt.Fprintf(os.Stderr, "failed to write comparison: %v\n", err)
+					os.Exit(ExitCodeOsError)
+				}
+			}
+
+			if comparison.Regressions > 0 {
+				os.Exit(ExitCodeCompareRegression)
+			}
+		},
+	}
+)
+
+func init() {
+	RootCmd.AddCommand(compareCmd)
+
+	compareCmd.Flags().StringVar(&compareFormat, "format", "text", "Output format, one of text or json.")
+	compareCmd.Flags().StringVar(&compareSettings.Metric, "metric", compareSettings.Metric, "Response time metric used to detect regressions, one of avg, p50, p90, p95, p99 or max.")
+	compareCmd.Flags().Float64Var(&compareSettings.Tolerance, "tolerance", compareSettings.Tolerance, "Allowed response time increase in percent.")
+	compareCmd.Flags().Float64Var(&compareSettings.ErrorRateTolerance, "errorrate-tolerance", compareSettings.ErrorRateTolerance, "Allowed error rate increase in percentage points.")
+	compareCmd.Flags().Float64Var(&compareSettings.BytesTolerance, "bytes-tolerance", compareSettings.BytesTolerance, "Allowed increase of sent and received bytes per action in percent.")
+	compareCmd.Flags().Uint64Var(&compareSettings.MinSamples, "minsamples", compareSettings.MinSamples, "Minimum amount of successful actions in both executions to compare response times.")
+	compareCmd.Flags().BoolVar(&compareNoColor, "nocolor", false, "Don't color text output.")
+}
@@ -21,9 +21,11 @@ import (
 	"github.com/pkg/errors"
 	"github.com/qlik-oss/gopherciser/config"
 	"github.com/qlik-oss/gopherciser/helpers"
+	"github.com/qlik-oss/gopherciser/metrics"
 	"github.com/qlik-oss/gopherciser/profile"
 	"github.com/qlik-oss/gopherciser/scheduler"
 	"github.com/qlik-oss/gopherciser/senseobjdef"
+	"github.com/qlik-oss/gopherciser/tracing"
 	"github.com/spf13/cobra"
 )
 
@@ -44,6 +46,8 @@ type (
 	OsError string
 	// SummaryTypeError incorrect summary type
 	SummaryTypeError string
+	// TracingError error starting tracing
+	TracingError string
 	// MaxErrorsReachedError max errors limit was reached during execution
 	MaxErrorsReachedError struct {
 		SubError error
@@ -60,9 +64,13 @@ var (
 	metricsAddress   string
 	metricsLabel     string
 	metricsGroupings []string
+	metricsToken     string
+	metricsInterval  time.Duration
 	profTyp          string
 	objDefFile       string
 	regression       bool
+	tui              bool
+	traceSettings    tracing.Settings
 )
 
 // MetricsLevel enum
@@ -71,6 +79,10 @@ const (
 	MetricPull
 	MetricPush
 	MetricPushAPI
+	MetricStatsD
+	MetricDogStatsD
+	MetricInflux
+	MetricOTLP
 )
 
 func (value MetricLevel) GetEnumMap() *enummap.EnumMap {
@@ -79,6 +91,10 @@ func (value MetricLevel) GetEnumMap() *enummap.EnumMap {
 		"pullmetric":    int(MetricPull),
 		"pushmetric":    int(MetricPush),
 		"pushmetricapi": int(MetricPushAPI),
+		"statsd":        int(MetricStatsD),
+		"dogstatsd":     int(MetricDogStatsD),
+		"influxmetric":  int(MetricInflux),
+		"otlpmetric":    int(MetricOTLP),
 	})
 
 	return metricLevelEnum
@@ -144,6 +160,11 @@ func (err SummaryTypeError) Error() string {
 	return string(err)
 }
 
+// Error implementation of Error interface
+func (err TracingError) Error() string {
+	return string(err)
+}
+
 // Error max error limit reached
 func (err MaxErrorsReachedError) Error() string {
 	msg := ""
@@ -209,6 +230,12 @@ var executeCmd = &cobra.Command{
 			case MaxErrorsReachedError:
 				errMsg = cErr.Error()
 				exitCode = ExitCodeMaxErrorsReached
+			case TracingError:
+				errMsg = fmt.Sprint("TracingError: ", execErr)
+				exitCode = ExitCodeTracingError
+			case config.ThresholdsError:
+				errMsg = fmt.Sprint("ThresholdsError: ", cErr)
+				exitCode = ExitCodeThresholdsFailed
 			default:
 				// only one error
 				errMsg = fmt.Sprint("1 error occurred:\n", execErr)
@@ -257,13 +284,22 @@ func init() {
 	AddLoggingParameters(executeCmd)
 
 	// Prometheus
-	executeCmd.Flags().StringVar(&metricsLevel, "metricslevel", "", "Export via http prometheus metrics. \n\t[0] nometrics (default)\n\t[1] pullmetric\n\t[2] pushmetric\n\t[3] pushmetricapi")
-	executeCmd.Flags().StringVar(&metricsTarget, "metricstarget", "", "if metricslevel is 1 then need to be the port as an int, if larger than 2 its the address of the push gateway")
+	executeCmd.Flags().StringVar(&metricsLevel, "metricslevel", "", "Export metrics. \n\t[0] nometrics (default)\n\t[1] pullmetric\n\t[2] pushmetric\n\t[3] pushmetricapi\n\t[4] statsd\n\t[5] dogstatsd\n\t[6] influxmetric\n\t[7] otlpmetric")
+	executeCmd.Flags().StringVar(&metricsTarget, "metricstarget", "", "if metricslevel is 1 then need to be the port as an int, if 2 or 3 its the address of the push gateway, if 4 or 5 its host:port of the StatsD server, if 6 its the URL of the InfluxDB write endpoint and if 7 its the OTLP endpoint as host:port or full URL")
 	executeCmd.Flags().IntVar(&metricsPort, "metrics", 0, "Deprecated use metricslevel instead, will attempt to convert at runtime")
 	executeCmd.Flags().StringVar(&metricsAddress, "metricsaddress", "", "Deprecated use metricstarget instead, will attempt to convert at runtime")
 	executeCmd.Flags().StringVar(&metricsLabel, "metricslabel", "gopherciser", "The job label to use for push metrics")
-	executeCmd.Flags().StringSliceVarP(&metricsGroupings, "metricsgroupingkey", "g", nil, "The grouping keys (in key=value form) to use for push metrics. Specify multiple times for more grouping keys.")
+	executeCmd.Flags().StringSliceVarP(&metricsGroupings, "metricsgroupingkey", "g", nil, "The grouping keys (in key=value form) to use for push metrics. Specify multiple times for more grouping keys. Added as tags when metricslevel is 4 or higher.")
+	executeCmd.Flags().StringVar(&metricsToken, "metricstoken", "", "Token used to authenticate towards InfluxDB.")
+	executeCmd.Flags().DurationVar(&metricsInterval, "metricsinterval", 0, "Interval between sending metrics when metricslevel is 4 or higher. Defaults to 1s for StatsD and 10s for InfluxDB and OTLP.")
 	executeCmd.Flags().BoolVar(&regression, "regression", false, "Log data needed to run regression analysis.")
+	executeCmd.Flags().BoolVar(&tui, "tui", false, "Show a live full-screen dashboard in the terminal instead of the status line. Can't be combined with console log formats.")
+
+	// OpenTelemetry tracing
+	executeCmd.Flags().StringVar(&traceSettings.Endpoint, "traceendpoint", "", "Export OpenTelemetry traces using OTLP over HTTP to endpoint, as host:port or full URL. Tracing is disabled when not set.")
+	executeCmd.Flags().BoolVar(&traceSettings.Insecure, "traceinsecure", false, "Use HTTP instead of HTTPS when traceendpoint is defined as host:port.")
+	executeCmd.Flags().StringVar(&traceSettings.ServiceName, "traceservicename", tracing.DefaultServiceName, "Service name of exported traces.")
+	executeCmd.Flags().Float64Var(&traceSettings.SampleRatio, "tracesampleratio", 1, "Ratio (0-1] of sessions to trace.")
 
 	// profiling
 	executeCmd.Flags().StringVar(&profTyp, "profile", "", profile.Help())
@@ -290,6 +326,10 @@ func execute() error {
 		cfg.Scheduler = scheduler.Regression()
 	}
 
+	if tui {
+		cfg.SetDashboard()
+	}
+
 	// === object definition section ===
 	if err := ReadObjectDefinitions(); err != nil {
 		return err
@@ -399,6 +439,35 @@ func execute() error {
 					if err != nil {
 						return MetricError(fmt.Sprintf("failed to start prometheus : %s ", err))
 					}
+				case MetricStatsD, MetricDogStatsD, MetricInflux, MetricOTLP:
+					tags, err := metrics.ParseTags(metricsGroupings)
+					if err != nil {
+						return MetricError(fmt.Sprintf("failed to start metrics : %s ", err))
+					}
+					reporterTypes := map[MetricLevel]metrics.ReporterType{
+						MetricStatsD:    metrics.ReporterStatsD,
+						MetricDogStatsD: metrics.ReporterDogStatsD,
+						MetricInflux:    metrics.ReporterInflux,
+						MetricOTLP:      metrics.ReporterOTLP,
+					}
+					stopMetrics, err := buildmetrics.StartReporter(ctx, metrics.ReporterSettings{
+						Type:        reporterTypes[metricsType],
+						Target:      metricsTarget,
+						Token:       metricsToken,
+						ServiceName: metricsLabel,
+						Interval:    metricsInterval,
+						Tags:        tags,
+					}, scenario.RegisteredActions())
+					if err != nil {
+						return MetricError(fmt.Sprintf("failed to start metrics : %s ", err))
+					}
+					defer func() {
+						flushCtx, flushCancel := context.WithTimeout(context.Background(), 30*time.Second)
+						defer flushCancel()
+						if err := stopMetrics(flushCtx); err != nil {
+							_, _ = fmt.Fprintf(os.Stderr, "%v\n", err)
+						}
+					}()
 				}
 			} else {
 				return MetricError(fmt.Sprintf("metricstarget must be set if metrics are enabled : <%s> ", metricsTarget))
@@ -406,6 +475,19 @@ func execute() error {
 		}
 	}
 
+	// === Tracing section ===
+	stopTracing, err := tracing.Setup(ctx, traceSettings)
+	if err != nil {
+		return TracingError(fmt.Sprintf("failed to start tracing: %v", err))
+	}
+	defer func() {
+		flushCtx, flushCancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer flushCancel()
+		if err := stopTracing(flushCtx); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "%v\n", err)
+		}
+	}()
+
 	// Data for variable templates
 	templateData := struct {
 		ConfigFile string
@@ -419,7 +501,7 @@ func execute() error {
 		cancel()
 	}
 
-	err := cfg.Execute(ctx, templateData)
+	err = cfg.Execute(ctx, templateData)
 	if msgErrorReachedMsg != nil {
 		return MaxErrorsReachedError{
 			Msg:      *msgErrorReachedMsg,
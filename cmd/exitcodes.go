@@ -35,4 +35,12 @@ const (
 	ExitCodeForceQuit
 	// ExitCodeMaxErrorsReached
 	ExitCodeMaxErrorsReached
+	// ExitCodeThresholdsFailed one or more thresholds failed
+	ExitCodeThresholdsFailed
+	// ExitCodeCompareRegression compare or regression diff found one or more regressions
+	ExitCodeCompareRegression
+	// ExitCodeTracingError error starting tracing
+	ExitCodeTracingError
+	// ExitCodeInvalidParameter parameter value could not be parsed
+	ExitCodeInvalidParameter
 )
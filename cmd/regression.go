@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/goccy/go-json"
+	"github.com/qlik-oss/gopherciser/analyze"
+	"github.com/shiena/ansicolor"
+	"github.com/spf13/cobra"
+)
+
+var (
+	regressionDiffFormat   string
+	regressionDiffMaxDiffs int
+	regressionDiffNoColor  bool
+
+	regressionCmd = &cobra.Command{
+		Use:   "regression",
+		Short: "Analyze regression logs.",
+		Long:  `Analyze regression logs written by executing a scenario with the --regression flag.`,
+	}
+
+	regressionDiffCmd = &cobra.Command{
+		Use:   "diff <baseline> <candidate>",
+		Short: "Compare object data of two regression logs.",
+		Long: `Compare object data of two regression logs, e.g. of executions against two Sense versions or before and after an
+app reload. Entries are matched by data ID and compared using the filters in the header of the baseline log. Objects
+returning different data are listed with the paths of the differing values and exit code is set to non-zero if any
+object differs or only exists in one of the logs.`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			format, err := compareFormatEnum.Int(regressionDiffFormat)
+			if err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "unknown format<%s>, expected one of %v\n", regressionDiffFormat, compareFormatEnum.Keys())
+				os.Exit(ExitCodeMissingParameter)
+			}
+
+			baseline, err := analyze.LoadRegressionLog(args[0])
+			if err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "failed to read baseline: %v\n", err)
+				os.Exit(ExitCodeOsError)
+			}
+			candidate, err := analyze.LoadRegressionLog(args[1])
+			if err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "failed to read candidate: %v\n", err)
+				os.Exit(ExitCodeOsError)
+			}
+
+			diff := analyze.DiffRegression(baseline, candidate)
+
+			switch CompareFormat(format) {
+			case CompareFormatJSON:
+				jsn, err := json.MarshalIndent(diff, "", "  ")
+				if err != nil {
+					_, _ = fmt.Fprintf(os.Stderr, "failed to marshal regression diff: %v\n", err)
+					os.Exit(ExitCodeExecutionError)
+				}
+				fmt.Println(string(jsn))
+			default:
+				if err := diff.WriteText(ansicolor.NewAnsiColorWriter(os.Stdout), regressionDiffMaxDiffs, !regressionDiffNoColor); err != nil {
+					_, _ = fmt.Fprintf(os.Stderr, "failed to write regression diff: %v\n", err)
+					os.Exit(ExitCodeOsError)
+				}
+			}
+
+			if diff.Different > 0 || diff.Missing > 0 {
+				os.Exit(ExitCodeCompareRegression)
+			}
+		},
+	}
+)
+
+func init() {
+	RootCmd.AddCommand(regressionCmd)
+	regressionCmd.AddCommand(regressionDiffCmd)
+
+	regressionDiffCmd.Flags().StringVar(&regressionDiffFormat, "format", "text", "Output format, one of text or json.")
+	regressionDiffCmd.Flags().IntVar(&regressionDiffMaxDiffs, "maxdiffs", 10, "Maximum amount of differences listed per object in text output, 0 lists all.")
+	regressionDiffCmd.Flags().BoolVar(&regressionDiffNoColor, "nocolor", false, "Don't color text output.")
+}
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/qlik-oss/gopherciser/analyze"
+	"github.com/qlik-oss/gopherciser/logger"
+	"github.com/spf13/cobra"
+)
+
+var (
+	reportOutput string
+	reportTitle  string
+
+	reportCmd = &cobra.Command{
+		Use:   "report <log> [log...]",
+		Short: "Generate HTML report from logs.",
+		Long: `Generate a self-contained HTML report from one or more TSV or JSON logs. The report contains response times,
+throughput and concurrency over time, per action percentiles, errors and warnings with sample stack traces and
+app and object type hot spots.`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			builder := analyze.NewReportBuilder()
+			for _, logFile := range args {
+				if err := readLogFile(logFile, builder.Add); err != nil {
+					_, _ = fmt.Fprintf(os.Stderr, "failed to read log<%s>: %v\n", logFile, err)
+					os.Exit(ExitCodeOsError)
+				}
+			}
+
+			title := reportTitle
+			if title == "" {
+				title = fmt.Sprintf("Gopherciser report: %s", filepath.Base(args[0]))
+			}
+
+			file, err := os.Create(reportOutput)
+			if err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "failed to create report<%s>: %v\n", reportOutput, err)
+				os.Exit(ExitCodeOsError)
+			}
+			if err := builder.Build(title).WriteHTML(file); err != nil {
+				_ = file.Close()
+				_, _ = fmt.Fprintf(os.Stderr, "failed to write report<%s>: %v\n", reportOutput, err)
+				os.Exit(ExitCodeOsError)
+			}
+			if err := file.Close(); err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "failed to close report<%s>: %v\n", reportOutput, err)
+				os.Exit(ExitCodeOsError)
+			}
+			fmt.Printf("%s written successfully.\n", reportOutput)
+		},
+	}
+)
+
+func init() {
+	RootCmd.AddCommand(reportCmd)
+
+	reportCmd.Flags().StringVarP(&reportOutput, "output", "o", "report.html", "Report file to write.")
+	reportCmd.Flags().StringVar(&reportTitle, "title", "", "Report title, defaults to name of first log.")
+}
+
+// readLogFile reads TSV or JSON log, optionally gzip or zstd compressed, and executes f for each row
+func readLogFile(logFile string, f func(row *analyze.LogRow)) error {
+	file, err := logger.OpenFile(logFile)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	return analyze.ReadLog(file, func(row *analyze.LogRow) error {
+		f(row)
+		return nil
+	})
+}
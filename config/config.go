@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/qlik-oss/gopherciser/action"
 	"github.com/qlik-oss/gopherciser/connection"
+	"github.com/qlik-oss/gopherciser/dashboard"
 	"github.com/qlik-oss/gopherciser/enummap"
 	"github.com/qlik-oss/gopherciser/helpers"
 	"github.com/qlik-oss/gopherciser/logger"
@@ -32,7 +34,7 @@ import (
 
 type (
 	// LogFormatType one of: LogFormatTSVFile, LogFormatTSVConsole,
-	//  LogFormatJSONFile, LogFormatJSONConsole, LogFormatColorConsole
+	//  LogFormatJSONFile, LogFormatJSONConsole, LogFormatColorConsole, LogFormatArrowFile
 	LogFormatType int
 
 	SummaryType int
@@ -52,6 +54,11 @@ type (
 		AppGUID     string
 		SuccessRate string
 		AvgResp     string
+		P50         string
+		P90         string
+		P95         string
+		P99         string
+		MaxResp     string
 		Requests    string
 		Errs        string
 		Warns       string
@@ -64,21 +71,46 @@ type (
 		Method   string
 		Path     string
 		AvgResp  string
+		P50      string
+		P90      string
+		P95      string
+		P99      string
+		MaxResp  string
 		Requests string
 		Sent     string
 		Received string
 	}
 
+	// SummaryEngineDataEntry data entry for engine method summary table
+	SummaryEngineDataEntry struct {
+		Method   string
+		AvgResp  string
+		P50      string
+		P90      string
+		P95      string
+		P99      string
+		MaxResp  string
+		Requests string
+		Errs     string
+		Sent     string
+		Received string
+	}
+
 	// LogSettings settings for logging
 	LogSettings struct {
-		Traffic         bool            `json:"traffic,omitempty" displayname:"Traffic log" doc-key:"config.settings.logs.traffic"`
-		Debug           bool            `json:"debug,omitempty" displayname:"Debug log" doc-key:"config.settings.logs.debug"`
-		TrafficMetrics  bool            `json:"metrics,omitempty" displayname:"Traffic metrics log" doc-key:"config.settings.logs.metrics"`
-		Regression      bool            `json:"regression,omitempty" displayname:"Regression log" doc-key:"config.settings.logs.regression"`
-		FileName        synced.Template `json:"filename" displayname:"Log filename" displayelement:"savefile" doc-key:"config.settings.logs.filename"`
-		Format          LogFormatType   `json:"format,omitempty" displayname:"Log format" doc-key:"config.settings.logs.format"`
-		Summary         SummaryType     `json:"summary,omitempty" displayname:"Summary type" doc-key:"config.settings.logs.summary"`
-		SummaryFileName string          `json:"summaryFilename,omitempty" displayname:"Name of summary file" doc-key:"config.settings.logs.summaryfile"`
+		Traffic         bool                `json:"traffic,omitempty" displayname:"Traffic log" doc-key:"config.settings.logs.traffic"`
+		Debug           bool                `json:"debug,omitempty" displayname:"Debug log" doc-key:"config.settings.logs.debug"`
+		TrafficMetrics  bool                `json:"metrics,omitempty" displayname:"Traffic metrics log" doc-key:"config.settings.logs.metrics"`
+		Regression      bool                `json:"regression,omitempty" displayname:"Regression log" doc-key:"config.settings.logs.regression"`
+		FileName        synced.Template     `json:"filename" displayname:"Log filename" displayelement:"savefile" doc-key:"config.settings.logs.filename"`
+		Format          LogFormatType       `json:"format,omitempty" displayname:"Log format" doc-key:"config.settings.logs.format"`
+		Summary         SummaryType         `json:"summary,omitempty" displayname:"Summary type" doc-key:"config.settings.logs.summary"`
+		SummaryFileName string              `json:"summaryFilename,omitempty" displayname:"Name of summary file" doc-key:"config.settings.logs.summaryfile"`
+		Interval        IntervalSettings    `json:"interval,omitempty" displayname:"Interval statistics" doc-key:"config.settings.logs.interval"`
+		Rotation        LogRotationSettings `json:"rotation,omitempty" displayname:"Log rotation" doc-key:"config.settings.logs.rotation"`
+
+		// dashboard replaces status output with a live terminal dashboard
+		dashboard bool
 	}
 
 	// OutputsSettings settings for produced outputs (if any)
@@ -114,6 +146,7 @@ type (
 		LoginSettings      users.UserGenerator           `json:"loginSettings"`
 		ConnectionSettings connection.ConnectionSettings `json:"connectionSettings"`
 		Hooks              Hooks                         `json:"hooks"`
+		Thresholds         Thresholds                    `json:"thresholds,omitempty"`
 	}
 
 	// Config setup and scenario to execute
@@ -175,6 +208,8 @@ const (
 	LogFormatNoLogs
 	// LogFormatOnlyStatus turns off all default logging except status, custom loggers will still be used
 	LogFormatOnlyStatus
+	// LogFormatArrowFile log to file in Apache Arrow IPC stream format, and status to console
+	LogFormatArrowFile
 )
 
 // SummaryType enum
@@ -203,6 +238,7 @@ func (value LogFormatType) GetEnumMap() *enummap.EnumMap {
 		"combined":    int(LogFormatTSVFileJSONConsole),
 		"no":          int(LogFormatNoLogs),
 		"onlystatus":  int(LogFormatOnlyStatus),
+		"arrowfile":   int(LogFormatArrowFile),
 	})
 
 	return logFormatEnum
@@ -475,6 +511,11 @@ func (cfg *Config) SetRegressionLogging() {
 	cfg.Settings.LogSettings.Regression = true
 }
 
+// SetDashboard override function to show live terminal dashboard instead of status output
+func (cfg *Config) SetDashboard() {
+	cfg.Settings.LogSettings.dashboard = true
+}
+
 func (cfg *Config) validateScheduler() error {
 	if cfg.Scheduler == nil {
 		if cfg.Options.AcceptNoScheduler {
@@ -548,6 +589,23 @@ func (cfg *Config) Validate() error {
 		cfg.ValidationWarnings = append(cfg.ValidationWarnings, w...)
 	}
 
+	// Validate interval statistics settings
+	if err := cfg.Settings.LogSettings.Interval.Validate(); err != nil {
+		return errors.Wrap(err, "interval statistics settings validation failed")
+	}
+
+	// Validate log rotation settings
+	if err := cfg.Settings.LogSettings.Rotation.Validate(); err != nil {
+		return errors.Wrap(err, "log rotation settings validation failed")
+	}
+
+	// Validate thresholds
+	if w, err := cfg.Thresholds.Validate(); err != nil {
+		return errors.WithStack(err)
+	} else if len(w) > 0 {
+		cfg.ValidationWarnings = append(cfg.ValidationWarnings, w...)
+	}
+
 	return nil
 }
 
@@ -612,9 +670,32 @@ func (cfg *Config) TestConnection(ctx context.Context) error {
 // Execute scenario (will be replaced by scheduler)
 func (cfg *Config) Execute(ctx context.Context, templateData any) error {
 	timeout := time.Duration(cfg.Settings.Timeout) * time.Second
+
+	// start statistics collection if summarylevel high enough
+	summaryType := cfg.Settings.LogSettings.getSummaryType()
+	if err := cfg.SetupStatistics(summaryType); err != nil {
+		return errors.WithStack(err)
+	}
+
+	// Live terminal dashboard replaces status output and shows errors and warnings from log
+	customLoggers := cfg.CustomLoggers
+	var dash *dashboard.Dashboard
+	if cfg.Settings.LogSettings.dashboard {
+		if !cfg.Settings.LogSettings.shouldLogStatus() {
+			format, _ := cfg.Settings.LogSettings.Format.GetEnumMap().String(int(cfg.Settings.LogSettings.Format))
+			return errors.Errorf("dashboard can't be used with console log format<%s>", format)
+		}
+		dash = dashboard.New(&cfg.Counters, cfg.concurrentUsers())
+		customLoggers = append(customLoggers[:len(customLoggers):len(customLoggers)], dash.Logger())
+	}
+	// Errors are grouped by fingerprint in summary
+	if cfg.Counters.StatisticsCollector.IsOn() {
+		customLoggers = append(customLoggers[:len(customLoggers):len(customLoggers)], newErrorStatsLogger(cfg.Counters.StatisticsCollector))
+	}
+
 	// Setup logging
 	logCtx, logCancel := context.WithCancel(ctx)
-	log, err := setupLogging(logCtx, cfg.Settings.LogSettings, cfg.CustomLoggers, templateData, &cfg.Counters)
+	log, err := setupLogging(logCtx, cfg.Settings.LogSettings, customLoggers, templateData, &cfg.Counters)
 	defer logCancel()
 	if err != nil {
 		return errors.WithStack(err)
@@ -653,14 +734,26 @@ func (cfg *Config) Execute(ctx context.Context, templateData any) error {
 		return errors.WithStack(err)
 	}
 
-	// start statistics collection if summarylevel high enough
-	summaryType := cfg.Settings.LogSettings.getSummaryType()
-	if err := cfg.SetupStatistics(summaryType); err != nil {
+	// Write time-series interval statistics during execution
+	stopInterval, err := startIntervalStatistics(ctx, cfg.Settings.LogSettings.Interval, templateData, &cfg.Counters)
+	if err != nil {
 		return errors.WithStack(err)
 	}
+	defer func() {
+		if err := stopInterval(); err != nil {
+			entry.LogError(errors.Wrap(err, "failed writing interval statistics"))
+		}
+	}()
 
 	// Log test summary after test is done
-	defer summary(log, summaryType, time.Now(), &cfg.Counters, cfg.Settings.LogSettings.SummaryFileName)
+	startTime := time.Now()
+	defer summary(log, summaryType, startTime, &cfg.Counters, cfg.Settings.LogSettings.SummaryFileName)
+
+	// dashboard is stopped before summary is printed
+	if dash != nil {
+		stopDashboard := dash.Start(ctx, ansiWriter, dashboard.DefaultInterval)
+		defer stopDashboard()
+	}
 
 	if cfg.Settings.MaxErrorCount > 0 {
 		var once sync.Once
@@ -702,11 +795,26 @@ func (cfg *Config) Execute(ctx context.Context, templateData any) error {
 		ctx, log, timeout, cfg.Scenario, outputsDir, cfg.LoginSettings, &cfg.ConnectionSettings, &cfg.Counters,
 	)
 
+	// Evaluate pass/fail thresholds
+	thresholdResults, thresholdErr := cfg.Thresholds.Evaluate(&cfg.Counters, time.Since(startTime))
+	thresholdReport(log, summaryType, thresholdResults)
+
 	if execErr != nil {
 		return errors.WithStack(execErr)
 	}
 
-	return nil
+	return thresholdErr
+}
+
+// concurrentUsers expected by scheduler, 0 if scheduler doesn't define concurrent users
+func (cfg *Config) concurrentUsers() int {
+	if cfg.Scheduler == nil {
+		return 0
+	}
+	data := make(map[string]interface{})
+	cfg.Scheduler.PopulateHookData(data)
+	users, _ := data["ConcurrentUsers"].(int)
+	return users
 }
 
 func (cfg *Config) PopulateHookData() {
@@ -726,6 +834,12 @@ func (cfg *Config) SetupStatistics(summary SummaryType) error {
 		cfg.Counters.StatisticsCollector = statistics.NewCollector()
 		return errors.WithStack(cfg.Counters.StatisticsCollector.SetLevel(statistics.StatsLevelFull))
 	}
+
+	// thresholds, interval statistics and dashboard are calculated from action statistics
+	if len(cfg.Thresholds) > 0 || cfg.Settings.LogSettings.Interval.Enabled() || cfg.Settings.LogSettings.dashboard {
+		cfg.Counters.StatisticsCollector = statistics.NewCollector()
+		return errors.WithStack(cfg.Counters.StatisticsCollector.SetLevel(statistics.StatsLevelOn))
+	}
 	return nil
 }
 
@@ -810,6 +924,38 @@ func summary(log *logger.Log, summary SummaryType, startTime time.Time, counters
 	entry.LogInfo("TotRequests", requests)
 	entry.LogInfo("TestDuration", strconv.FormatInt(testDuration.Nanoseconds(), 10))
 
+	PrintSummary(summary, testDuration, counters, summaryFilename)
+}
+
+// summaryEntries creates summary totals entries for summary type
+func summaryEntries(summary SummaryType, testDuration time.Duration, counters *statistics.ExecutionCounters) []SummaryEntry {
+	var extended bool
+	switch summary {
+	case SummaryTypeFull, SummaryTypeExtended, SummaryTypeFile:
+		extended = true
+	}
+
+	totals := statistics.SummaryTotals(extended, testDuration, counters)
+	summaryData := make([]SummaryEntry, 0, len(totals))
+	for _, total := range totals {
+		color := ansiBoldBlue
+		switch {
+		case total.ShortTitle == "TotErrors" && counters.Errors.Current() > 0:
+			color = ansiBoldRed
+		case total.ShortTitle == "TotWarnings" && counters.Warnings.Current() > 0:
+			color = ansiBoldYellow
+		}
+		summaryData = append(summaryData, SummaryEntry{total.LongTitle, total.ShortTitle, total.Value, color})
+	}
+
+	return summaryData
+}
+
+// PrintSummary of execution counters to console, or to summary file if summary type is file
+func PrintSummary(summary SummaryType, testDuration time.Duration, counters *statistics.ExecutionCounters, summaryFilename string) {
+	errs := counters.Errors.Current()
+	warnings := counters.Warnings.Current()
+
 	buf := helpers.NewBuffer()
 	defer func() {
 		if buf.Error != nil {
@@ -824,59 +970,30 @@ func summary(log *logger.Log, summary SummaryType, startTime time.Time, counters
 		}
 	}()
 
-	errorColor := ansiBoldBlue
-	warningColor := ansiBoldBlue
-
-	if errs > 0 {
-		errorColor = ansiBoldRed
-	}
-
-	if warnings > 0 {
-		warningColor = ansiBoldYellow
-	}
-
-	summaryData := []SummaryEntry{
-		{"Total errors", "TotErrors", strconv.FormatUint(errs, 10), errorColor},
-		{"Total warnings", "TotWarnings", strconv.FormatUint(warnings, 10), warningColor},
-		{"Total actions", "TotActions", actions, ansiBoldBlue},
-		{"Total requests", "TotRequests", requests, ansiBoldBlue},
-		{"Duration", "Duration", testDuration.String(), ansiBoldBlue},
-	}
-
 	// Decide summary output
 	switch summary {
 	case SummaryTypeNone:
 		//Don't log summary to stdout
 		return
 	case SummaryTypeFull, SummaryTypeExtended, SummaryTypeFile:
-		summaryData = append(summaryData, []SummaryEntry{
-			{"Total users", "TotUsers", strconv.FormatUint(counters.Users.Current(), 10), ansiBoldBlue},
-			{"Total threads", "TotThreads", strconv.FormatUint(counters.Threads.Current(), 10), ansiBoldBlue},
-			{"Total sessions", "TotSessions", strconv.FormatUint(counters.Sessions.Current(), 10), ansiBoldBlue},
-			{"Total apps opened", "OpenedApps", strconv.FormatUint(counters.StatisticsCollector.OpenedApps(), 10), ansiBoldBlue},
-			{"Total apps created", "CreatedApps", strconv.FormatUint(counters.StatisticsCollector.CreatedApps(), 10), ansiBoldBlue},
-		}...)
 	default:
 		// default to simple summary
 		summary = SummaryTypeSimple
 	}
 
 	if summary == SummaryTypeFile {
-		jsn, err := json.Marshal(summaryData)
-		if err != nil {
-			_, _ = fmt.Fprint(os.Stderr, "failed to marshal summary file:", err)
-			return
-		}
 		fileName := DefaultSummaryFilename
 		if summaryFilename != "" {
 			fileName = summaryFilename
 		}
-		if err := os.WriteFile(fileName, jsn, 0644); err != nil {
+		if err := statistics.NewSummaryFile(testDuration, counters).Write(fileName); err != nil {
 			_, _ = fmt.Fprint(os.Stderr, "failed write summary file:", err)
 		}
 		return
 	}
 
+	summaryData := summaryEntries(summary, testDuration, counters)
+
 	buf.WriteString(ansiReset)
 	for _, v := range summaryData {
 		buf.WriteString(v.Color)
@@ -906,6 +1023,7 @@ func summary(log *logger.Log, summary SummaryType, startTime time.Time, counters
 	summaryHeaders["app"] = &SummaryHeaderEntry{"AppGUID", 7}
 	summaryHeaders["success"] = &SummaryHeaderEntry{"SuccessRate", 11}
 	summaryHeaders["resp"] = &SummaryHeaderEntry{"AvgResp", 7}
+	addPercentileHeaders(summaryHeaders)
 	summaryHeaders["req"] = &SummaryHeaderEntry{"Requests", 8}
 	summaryHeaders["errs"] = &SummaryHeaderEntry{"Errors", 6}
 	summaryHeaders["warns"] = &SummaryHeaderEntry{"Warnings", 8}
@@ -917,6 +1035,7 @@ func summary(log *logger.Log, summary SummaryType, startTime time.Time, counters
 	counters.StatisticsCollector.ForEachAction(func(stats *statistics.ActionStats) {
 		// add data entry
 		resp, successful := stats.RespAvg.Average()
+		percentiles, _ := stats.RespAvg.Percentiles()
 		failed := stats.Failed.Current()
 
 		// calculate success rate
@@ -934,7 +1053,12 @@ func summary(log *logger.Log, summary SummaryType, startTime time.Time, counters
 			Label:       stats.Label(),
 			AppGUID:     stats.AppGUID(),
 			SuccessRate: fmt.Sprintf("%.2f%%", successRate),
-			AvgResp:     time.Duration(resp).Round(time.Millisecond).String(),
+			AvgResp:     durationString(resp),
+			P50:         durationString(float64(percentiles.P50)),
+			P90:         durationString(float64(percentiles.P90)),
+			P95:         durationString(float64(percentiles.P95)),
+			P99:         durationString(float64(percentiles.P99)),
+			MaxResp:     durationString(float64(percentiles.Max)),
 			Requests:    stats.Requests.String(),
 			Errs:        stats.ErrCount.String(),
 			Warns:       stats.WarnCount.String(),
@@ -948,6 +1072,7 @@ func summary(log *logger.Log, summary SummaryType, startTime time.Time, counters
 		summaryHeaders["app"].UpdateColSize(len(stats.AppGUID()))
 		summaryHeaders["success"].UpdateColSize(len(entry.SuccessRate))
 		summaryHeaders["resp"].UpdateColSize(len(entry.AvgResp))
+		summaryHeaders.updatePercentileColSizes(entry.P50, entry.P90, entry.P95, entry.P99, entry.MaxResp)
 		summaryHeaders["req"].UpdateColSize(len(entry.Requests))
 		summaryHeaders["errs"].UpdateColSize(len(entry.Errs))
 		summaryHeaders["warns"].UpdateColSize(len(entry.Warns))
@@ -962,7 +1087,7 @@ func summary(log *logger.Log, summary SummaryType, startTime time.Time, counters
 		summaryHeaders.Col(v, &tabbedOutput)
 	}
 
-	for _, v := range []string{"success", "resp", "req", "errs", "warns", "sent", "recvd"} {
+	for _, v := range []string{"success", "resp", "p50", "p90", "p95", "p99", "max", "req", "errs", "warns", "sent", "recvd"} {
 		summaryHeaders.ColRJ(v, &tabbedOutput)
 	}
 
@@ -972,13 +1097,16 @@ func summary(log *logger.Log, summary SummaryType, startTime time.Time, counters
 
 	for _, v := range actionTblData {
 		buf.WriteString(ansiBoldBlue)
-		buf.WriteString(fmt.Sprintf(table.Format, v.Action, v.Label, v.AppGUID, v.SuccessRate, v.AvgResp, v.Requests, v.Errs, v.Warns, v.Sent, v.Received))
+		buf.WriteString(fmt.Sprintf(table.Format, v.Action, v.Label, v.AppGUID, v.SuccessRate, v.AvgResp, v.P50, v.P90, v.P95, v.P99, v.MaxResp, v.Requests, v.Errs, v.Warns, v.Sent, v.Received))
 		buf.WriteString(ansiReset)
 	}
 
 	// Separate sections
 	buf.WriteString("\n")
 
+	// Errors grouped by fingerprint
+	writeErrorSummary(buf, counters.StatisticsCollector)
+
 	summaryHeaders = make(SummaryHeader)
 	requestsTblData := make([]SummaryRequestDataEntry, 0, counters.StatisticsCollector.RESTRequestLen())
 
@@ -986,16 +1114,23 @@ func summary(log *logger.Log, summary SummaryType, startTime time.Time, counters
 	summaryHeaders["path"] = &SummaryHeaderEntry{"Endpoint", 8}
 	summaryHeaders["method"] = &SummaryHeaderEntry{"Method", 6}
 	summaryHeaders["resp"] = &SummaryHeaderEntry{"AvgResp", 7}
+	addPercentileHeaders(summaryHeaders)
 	summaryHeaders["req"] = &SummaryHeaderEntry{"Requests", 8}
 	summaryHeaders["sent"] = &SummaryHeaderEntry{"Sent (Bytes)", 11}
 	summaryHeaders["recvd"] = &SummaryHeaderEntry{"Received (Bytes)", 16}
 
 	counters.StatisticsCollector.ForEachRequest(func(stats *statistics.RequestStats) {
 		resp, requests := stats.RespAvg.Average()
+		percentiles, _ := stats.RespAvg.Percentiles()
 		entry := SummaryRequestDataEntry{
 			Method:   stats.Method(),
 			Path:     stats.Path(),
-			AvgResp:  time.Duration(resp).Round(time.Millisecond).String(),
+			AvgResp:  durationString(resp),
+			P50:      durationString(float64(percentiles.P50)),
+			P90:      durationString(float64(percentiles.P90)),
+			P95:      durationString(float64(percentiles.P95)),
+			P99:      durationString(float64(percentiles.P99)),
+			MaxResp:  durationString(float64(percentiles.Max)),
 			Requests: strconv.FormatUint(requests, 10),
 			Sent:     stats.Sent.String(),
 			Received: stats.Received.String(),
@@ -1004,6 +1139,7 @@ func summary(log *logger.Log, summary SummaryType, startTime time.Time, counters
 		summaryHeaders["path"].UpdateColSize(len(stats.Path()))
 		summaryHeaders["method"].UpdateColSize(len(stats.Method()))
 		summaryHeaders["resp"].UpdateColSize(len(entry.AvgResp))
+		summaryHeaders.updatePercentileColSizes(entry.P50, entry.P90, entry.P95, entry.P99, entry.MaxResp)
 		summaryHeaders["req"].UpdateColSize(len(entry.Requests))
 		summaryHeaders["sent"].UpdateColSize(len(entry.Sent))
 		summaryHeaders["recvd"].UpdateColSize(len(entry.Received))
@@ -1021,7 +1157,7 @@ func summary(log *logger.Log, summary SummaryType, startTime time.Time, counters
 		summaryHeaders.Col(v, &tabbedOutput)
 	}
 
-	for _, v := range []string{"resp", "req", "sent", "recvd"} {
+	for _, v := range []string{"resp", "p50", "p90", "p95", "p99", "max", "req", "sent", "recvd"} {
 		summaryHeaders.ColRJ(v, &tabbedOutput)
 	}
 
@@ -1031,10 +1167,96 @@ func summary(log *logger.Log, summary SummaryType, startTime time.Time, counters
 
 	for _, v := range requestsTblData {
 		buf.WriteString(ansiBoldBlue)
-		buf.WriteString(fmt.Sprintf(table.Format, v.Path, v.Method, v.AvgResp, v.Requests, v.Sent, v.Received))
+		buf.WriteString(fmt.Sprintf(table.Format, v.Path, v.Method, v.AvgResp, v.P50, v.P90, v.P95, v.P99, v.MaxResp, v.Requests, v.Sent, v.Received))
+		buf.WriteString(ansiReset)
+	}
+
+	if counters.StatisticsCollector.EngineMethodsLen() < 1 {
+		return
+	}
+
+	// Separate sections
+	buf.WriteString("\n")
+
+	summaryHeaders = make(SummaryHeader)
+	engineTblData := make([]SummaryEngineDataEntry, 0, counters.StatisticsCollector.EngineMethodsLen())
+
+	// Create headers and default column sizes
+	summaryHeaders["method"] = &SummaryHeaderEntry{"Engine method", 13}
+	summaryHeaders["resp"] = &SummaryHeaderEntry{"AvgResp", 7}
+	addPercentileHeaders(summaryHeaders)
+	summaryHeaders["req"] = &SummaryHeaderEntry{"Requests", 8}
+	summaryHeaders["errs"] = &SummaryHeaderEntry{"Errors", 6}
+	summaryHeaders["sent"] = &SummaryHeaderEntry{"Sent (Bytes)", 11}
+	summaryHeaders["recvd"] = &SummaryHeaderEntry{"Received (Bytes)", 16}
+
+	counters.StatisticsCollector.ForEachEngineMethod(func(stats *statistics.EngineStats) {
+		resp, requests := stats.RespAvg.Average()
+		percentiles, _ := stats.RespAvg.Percentiles()
+		entry := SummaryEngineDataEntry{
+			Method:   stats.Method(),
+			AvgResp:  durationString(resp),
+			P50:      durationString(float64(percentiles.P50)),
+			P90:      durationString(float64(percentiles.P90)),
+			P95:      durationString(float64(percentiles.P95)),
+			P99:      durationString(float64(percentiles.P99)),
+			MaxResp:  durationString(float64(percentiles.Max)),
+			Requests: strconv.FormatUint(requests, 10),
+			Errs:     stats.Errors.String(),
+			Sent:     stats.Sent.String(),
+			Received: stats.Received.String(),
+		}
+		engineTblData = append(engineTblData, entry)
+		summaryHeaders["method"].UpdateColSize(len(stats.Method()))
+		summaryHeaders["resp"].UpdateColSize(len(entry.AvgResp))
+		summaryHeaders.updatePercentileColSizes(entry.P50, entry.P90, entry.P95, entry.P99, entry.MaxResp)
+		summaryHeaders["req"].UpdateColSize(len(entry.Requests))
+		summaryHeaders["errs"].UpdateColSize(len(entry.Errs))
+		summaryHeaders["sent"].UpdateColSize(len(entry.Sent))
+		summaryHeaders["recvd"].UpdateColSize(len(entry.Received))
+	})
+	sort.Slice(engineTblData, func(i, j int) bool {
+		return engineTblData[i].Method < engineTblData[j].Method
+	})
+
+	// Engine methods table
+	tabbedOutput = tabular.New()
+	summaryHeaders.Col("method", &tabbedOutput)
+	for _, v := range []string{"resp", "p50", "p90", "p95", "p99", "max", "req", "errs", "sent", "recvd"} {
+		summaryHeaders.ColRJ(v, &tabbedOutput)
+	}
+
+	table = tabbedOutput.Parse("*")
+	writeTableHeaders(buf, &table)
+
+	for _, v := range engineTblData {
+		buf.WriteString(ansiBoldBlue)
+		buf.WriteString(fmt.Sprintf(table.Format, v.Method, v.AvgResp, v.P50, v.P90, v.P95, v.P99, v.MaxResp, v.Requests, v.Errs, v.Sent, v.Received))
 		buf.WriteString(ansiReset)
 	}
+}
 
+// durationString formats nanoseconds as duration rounded to milliseconds
+func durationString(ns float64) string {
+	return time.Duration(ns).Round(time.Millisecond).String()
+}
+
+// addPercentileHeaders adds response time percentile columns to header
+func addPercentileHeaders(header SummaryHeader) {
+	header["p50"] = &SummaryHeaderEntry{"P50", 3}
+	header["p90"] = &SummaryHeaderEntry{"P90", 3}
+	header["p95"] = &SummaryHeaderEntry{"P95", 3}
+	header["p99"] = &SummaryHeaderEntry{"P99", 3}
+	header["max"] = &SummaryHeaderEntry{"MaxResp", 7}
+}
+
+// updatePercentileColSizes updates column sizes of percentile columns
+func (header SummaryHeader) updatePercentileColSizes(p50, p90, p95, p99, maxResp string) {
+	header["p50"].UpdateColSize(len(p50))
+	header["p90"].UpdateColSize(len(p90))
+	header["p95"].UpdateColSize(len(p95))
+	header["p99"].UpdateColSize(len(p99))
+	header["max"].UpdateColSize(len(maxResp))
 }
 
 func writeTableHeaders(buf *helpers.Buffer, table *tabular.Output) {
@@ -1107,8 +1329,8 @@ func setupOutputs(settings OutputsSettings) (string, error) {
 	return absPath, err
 }
 
-func addTSVFileLogger(log *logger.Log, filename string) error {
-	filewriter, closeFile, fileWriterErr := createFileWriter(filename)
+func addTSVFileLogger(log *logger.Log, segmentName logger.SegmentNameFunc, rotation LogRotationSettings) error {
+	filewriter, closeFile, fileWriterErr := createFileWriter(segmentName, rotation.writerSettings(true))
 	if fileWriterErr != nil {
 		return errors.WithStack(fileWriterErr)
 	}
@@ -1121,6 +1343,23 @@ func addTSVFileLogger(log *logger.Log, filename string) error {
 	return nil
 }
 
+func addArrowFileLogger(log *logger.Log, segmentName logger.SegmentNameFunc, rotation LogRotationSettings) error {
+	// every segment is a complete stream starting with schema and ending with end of stream marker
+	writerSettings := rotation.writerSettings(true)
+	writerSettings.Footer = logger.ArrowEndOfStream
+	filewriter, closeFile, fileWriterErr := createFileWriter(segmentName, writerSettings)
+	if fileWriterErr != nil {
+		return errors.WithStack(fileWriterErr)
+	}
+
+	arrowLogger, arrowErr := logger.CreateArrowLogger(filewriter, closeFile)
+	if arrowErr != nil {
+		return errors.WithStack(arrowErr)
+	}
+	log.AddLoggers(arrowLogger)
+	return nil
+}
+
 func setupLogging(ctx context.Context, settings LogSettings, customLoggers []*logger.Logger, templateData interface{}, counters *statistics.ExecutionCounters) (*logger.Log, error) {
 	log := logger.NewLog(logger.LogSettings{
 		Traffic:    settings.Traffic,
@@ -1134,6 +1373,14 @@ func setupLogging(ctx context.Context, settings LogSettings, customLoggers []*lo
 		return nil, errors.Wrap(err, "failed to expand session variables in filename")
 	}
 
+	// segment 0 re-uses already expanded filename to keep functions such as timestamp consistent with other outputs
+	segmentName := func(segment int) (string, error) {
+		if segment == 0 {
+			return filename, nil
+		}
+		return settings.FileName.ExecuteStringWithSegment(templateData, segment)
+	}
+
 	if log.Settings.Regression {
 		err := log.SetRegressionLoggerFile(filename)
 		if err != nil {
@@ -1143,7 +1390,7 @@ func setupLogging(ctx context.Context, settings LogSettings, customLoggers []*lo
 
 	switch settings.Format {
 	case LogFormatTSVFile: // TSV log file
-		if err := addTSVFileLogger(log, filename); err != nil {
+		if err := addTSVFileLogger(log, segmentName, settings.Rotation); err != nil {
 			return log, errors.WithStack(err)
 		}
 	case LogFormatTSVConsole: // TSV console
@@ -1153,7 +1400,7 @@ func setupLogging(ctx context.Context, settings LogSettings, customLoggers []*lo
 		}
 		log.AddLoggers(tsvLogger)
 	case LogFormatJSONFile: // JSON log file
-		filewriter, closeFile, fileWriterErr := createFileWriter(filename)
+		filewriter, closeFile, fileWriterErr := createFileWriter(segmentName, settings.Rotation.writerSettings(false))
 		if fileWriterErr != nil {
 			return log, errors.WithStack(fileWriterErr)
 		}
@@ -1167,12 +1414,16 @@ func setupLogging(ctx context.Context, settings LogSettings, customLoggers []*lo
 		stdout := logger.CreateStdoutLogger()
 		log.AddLoggers(stdout)
 	case LogFormatTSVFileJSONConsole: // TSV file, JSON console
-		if err := addTSVFileLogger(log, filename); err != nil {
+		if err := addTSVFileLogger(log, segmentName, settings.Rotation); err != nil {
 			return log, errors.WithStack(err)
 		}
 		stdoutJSON := logger.CreateStdoutJSONLogger()
 		log.AddLoggers(stdoutJSON)
 
+	case LogFormatArrowFile: // Arrow IPC log file
+		if err := addArrowFileLogger(log, segmentName, settings.Rotation); err != nil {
+			return log, errors.WithStack(err)
+		}
 	case LogFormatNoLogs: // No default logging
 	case LogFormatOnlyStatus:
 		// add dummy logger to make sure logs are not congesting log channel
@@ -1185,7 +1436,7 @@ func setupLogging(ctx context.Context, settings LogSettings, customLoggers []*lo
 		log.AddLoggers(customLoggers...)
 	}
 
-	if settings.shouldLogStatus() {
+	if settings.shouldLogStatus() && !settings.dashboard {
 		// status output
 		go statusPrinter(ctx, 10*time.Second, log.Closed, counters)
 	}
@@ -1272,8 +1523,8 @@ func statusPrinter(ctx context.Context, statusDelay time.Duration, closeChan cha
 	}
 }
 
-func createFileWriter(filename string) (io.Writer, func() error, error) {
-	writer, err := logger.NewWriter(filename)
+func createFileWriter(segmentName logger.SegmentNameFunc, rotation logger.RotationSettings) (io.Writer, func() error, error) {
+	writer, err := logger.NewRotatingWriter(rotation, segmentName)
 	if err != nil {
 		return nil, nil, errors.WithStack(err)
 	}
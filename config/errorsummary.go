@@ -0,0 +1,91 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/InVisionApp/tabular"
+	"github.com/qlik-oss/gopherciser/helpers"
+	"github.com/qlik-oss/gopherciser/logger"
+	"github.com/qlik-oss/gopherciser/statistics"
+)
+
+type (
+	// errorStatsWriter logger.MsgWriter grouping logged errors by fingerprint
+	errorStatsWriter struct {
+		collector *statistics.Collector
+	}
+)
+
+const (
+	maxErrorMessageLength = 100
+	errorTimeFormat       = "2006-01-02 15:04:05"
+)
+
+func newErrorStatsLogger(collector *statistics.Collector) *logger.Logger {
+	return logger.NewLogger(&errorStatsWriter{collector: collector})
+}
+
+// WriteMessage implement logger.MsgWriter interface
+func (writer *errorStatsWriter) WriteMessage(msg *logger.LogChanMsg) error {
+	if msg == nil || msg.Level != logger.ErrorLevel || msg.Fingerprint == "" {
+		return nil
+	}
+	writer.collector.GetOrAddErrorStats(msg.Fingerprint, msg.ErrorCategory, msg.ErrorCode, msg.ErrorMessage).Add(msg.Time)
+	return nil
+}
+
+// Level implement logger.MsgWriter interface
+func (writer *errorStatsWriter) Level(lvl logger.LogLevel) {}
+
+// writeErrorSummary writes table of errors grouped by fingerprint
+func writeErrorSummary(buf *helpers.Buffer, collector *statistics.Collector) {
+	entries := statistics.SummaryErrorEntries(collector)
+	if len(entries) < 1 {
+		return
+	}
+
+	summaryHeaders := make(SummaryHeader)
+	summaryHeaders["fingerprint"] = &SummaryHeaderEntry{"Fingerprint", 16}
+	summaryHeaders["category"] = &SummaryHeaderEntry{"Category", 8}
+	summaryHeaders["code"] = &SummaryHeaderEntry{"Code", 4}
+	summaryHeaders["count"] = &SummaryHeaderEntry{"Count", 5}
+	summaryHeaders["first"] = &SummaryHeaderEntry{"First", len(errorTimeFormat)}
+	summaryHeaders["last"] = &SummaryHeaderEntry{"Last", len(errorTimeFormat)}
+	summaryHeaders["message"] = &SummaryHeaderEntry{"Message", 7}
+
+	messages := make([]string, len(entries))
+	for i, entry := range entries {
+		messages[i] = strings.Join(strings.Fields(entry.Message), " ")
+		if len(messages[i]) > maxErrorMessageLength {
+			messages[i] = messages[i][:maxErrorMessageLength-3] + "..."
+		}
+		summaryHeaders["category"].UpdateColSize(len(entry.Category))
+		summaryHeaders["code"].UpdateColSize(len(entry.Code))
+		summaryHeaders["count"].UpdateColSize(len(strconv.FormatUint(entry.Count, 10)))
+		summaryHeaders["message"].UpdateColSize(len(messages[i]))
+	}
+
+	tabbedOutput := tabular.New()
+	for _, v := range []string{"fingerprint", "category", "code"} {
+		summaryHeaders.Col(v, &tabbedOutput)
+	}
+	summaryHeaders.ColRJ("count", &tabbedOutput)
+	for _, v := range []string{"first", "last", "message"} {
+		summaryHeaders.Col(v, &tabbedOutput)
+	}
+
+	table := tabbedOutput.Parse("*")
+	writeTableHeaders(buf, &table)
+
+	for i, entry := range entries {
+		buf.WriteString(ansiBoldRed)
+		buf.WriteString(fmt.Sprintf(table.Format, entry.Fingerprint, entry.Category, entry.Code, strconv.FormatUint(entry.Count, 10),
+			entry.First.Format(errorTimeFormat), entry.Last.Format(errorTimeFormat), messages[i]))
+		buf.WriteString(ansiReset)
+	}
+
+	// Separate sections
+	buf.WriteString("\n")
+}
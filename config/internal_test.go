@@ -2,9 +2,12 @@ package config
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/goccy/go-json"
 	"github.com/qlik-oss/gopherciser/logger"
 	"github.com/qlik-oss/gopherciser/statistics"
 )
@@ -66,6 +69,22 @@ func TestConfigSummary(t *testing.T) {
 	chStats.WarnCount.Add(1)
 	chStats.Requests.Add(123)
 
+	// errors with same fingerprint are grouped
+	errWriter := &errorStatsWriter{collector: counters.StatisticsCollector}
+	for _, objectID := range []string{"aBcD12", "xYz987", "aBcD12"} {
+		msg := logger.NewEmptyLogChanMsg()
+		msg.Level = logger.ErrorLevel
+		msg.Time = startTime
+		msg.Message = fmt.Sprintf("object<%s> not found", objectID)
+		class := logger.ClassifyError(msg.Message, nil)
+		msg.ErrorCategory = class.Category.String()
+		msg.Fingerprint = class.Fingerprint
+		msg.ErrorMessage = class.Message
+		if err := errWriter.WriteMessage(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
 	fmt.Println("simple (dirty):")
 	summary(log, SummaryTypeSimple, startTime, counters, "")
 	fmt.Println()
@@ -81,11 +100,51 @@ func TestConfigSummary(t *testing.T) {
 	usersStats.RespAvg.AddSample(uint64(time.Millisecond * 400))
 	usersStats.Received.Add(2)
 	usersStats.Sent.Add(432)
+	layoutStats := counters.StatisticsCollector.GetOrAddEngineStats("GetLayout")
+	layoutStats.RespAvg.AddSample(uint64(time.Millisecond * 120))
+	layoutStats.Errors.Inc()
+	layoutStats.Sent.Add(80)
+	layoutStats.Received.Add(5120)
 
 	fmt.Println("full (dirty):")
 	summary(log, SummaryTypeFull, startTime, counters, "")
 	fmt.Println()
 
+	summaryFileName := filepath.Join(t.TempDir(), "summary.json")
+	summary(log, SummaryTypeFile, startTime, counters, summaryFileName)
+	raw, err := os.ReadFile(summaryFileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var summaryFile statistics.SummaryFile
+	if err := json.Unmarshal(raw, &summaryFile); err != nil {
+		t.Fatal(err)
+	}
+	if summaryFile.Version != statistics.SummaryFileVersion {
+		t.Errorf("summary file version<%d> expected<%d>", summaryFile.Version, statistics.SummaryFileVersion)
+	}
+	if len(summaryFile.Actions) != 2 {
+		t.Errorf("summary file has %d actions, expected 2", len(summaryFile.Actions))
+	}
+	if len(summaryFile.Requests) != 1 {
+		t.Fatalf("summary file has %d requests, expected 1", len(summaryFile.Requests))
+	}
+	if p95 := time.Duration(summaryFile.Requests[0].Percentiles.P95); p95.Round(time.Millisecond) != 400*time.Millisecond {
+		t.Errorf("summary file request p95<%v> expected<400ms>", p95)
+	}
+	if len(summaryFile.EngineMethods) != 1 {
+		t.Fatalf("summary file has %d engine methods, expected 1", len(summaryFile.EngineMethods))
+	}
+	if engine := summaryFile.EngineMethods[0]; engine.Method != "GetLayout" || engine.Requests != 1 || engine.Errors != 1 || engine.Received != 5120 {
+		t.Errorf("unexpected summary file engine method entry<%+v>", engine)
+	}
+	if len(summaryFile.Errors) != 1 {
+		t.Fatalf("summary file has %d error fingerprints, expected 1", len(summaryFile.Errors))
+	}
+	if errEntry := summaryFile.Errors[0]; errEntry.Count != 3 || errEntry.Category != "other" || errEntry.Message != "object<*> not found" {
+		t.Errorf("unexpected summary file error entry<%+v>", errEntry)
+	}
+
 	// Reset global counter to not effect other tests
 	counters.Errors.Reset()
 	counters.Warnings.Reset()
@@ -0,0 +1,227 @@
+package config
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/gopherciser/enummap"
+	"github.com/qlik-oss/gopherciser/helpers"
+	"github.com/qlik-oss/gopherciser/statistics"
+	"github.com/qlik-oss/gopherciser/synced"
+)
+
+type (
+	IntervalFormat int
+
+	// IntervalSettings settings for time-series interval statistics output
+	IntervalSettings struct {
+		Period   helpers.TimeDuration `json:"period,omitempty" displayname:"Interval period" doc-key:"config.settings.logs.interval.period"`
+		FileName synced.Template      `json:"filename,omitempty" displayname:"Interval filename" displayelement:"savefile" doc-key:"config.settings.logs.interval.filename"`
+		Format   IntervalFormat       `json:"format,omitempty" displayname:"Interval format" doc-key:"config.settings.logs.interval.format"`
+	}
+
+	intervalWriter struct {
+		format    IntervalFormat
+		file      io.WriteCloser
+		buf       *bufio.Writer
+		csv       *csv.Writer
+		collector *statistics.IntervalCollector
+	}
+)
+
+// IntervalFormat enum
+const (
+	IntervalFormatCSV IntervalFormat = iota
+	IntervalFormatJSONL
+)
+
+// DefaultIntervalFilename used when no interval filename is defined
+const DefaultIntervalFilename = "intervals.csv"
+
+var (
+	intervalFormatEnum = enummap.NewEnumMapOrPanic(map[string]int{
+		"csv":   int(IntervalFormatCSV),
+		"jsonl": int(IntervalFormatJSONL),
+	})
+
+	intervalCSVHeader = []string{
+		"timestamp", "interval", "type", "action", "label", "appguid", "actions", "failed", "errors", "warnings",
+		"requests", "sent", "received", "activeusers", "throughput", "avgresp", "p50", "p90", "p95", "p99", "max",
+	}
+)
+
+// GetEnumMap of IntervalFormat for GUI
+func (format IntervalFormat) GetEnumMap() *enummap.EnumMap {
+	return intervalFormatEnum
+}
+
+// UnmarshalJSON IntervalFormat
+func (format *IntervalFormat) UnmarshalJSON(arg []byte) error {
+	i, err := intervalFormatEnum.UnMarshal(arg)
+	if err != nil {
+		return errors.Wrap(err, "Failed to unmarshal IntervalFormat")
+	}
+	*format = IntervalFormat(i)
+	return nil
+}
+
+// MarshalJSON marshal IntervalFormat
+func (format IntervalFormat) MarshalJSON() ([]byte, error) {
+	str, err := intervalFormatEnum.String(int(format))
+	if err != nil {
+		return nil, errors.Errorf("Unknown IntervalFormat<%d>", format)
+	}
+	return []byte(fmt.Sprintf(`"%s"`, str)), nil
+}
+
+// Enabled returns true if interval statistics should be written
+func (settings *IntervalSettings) Enabled() bool {
+	return settings != nil && settings.Period > 0
+}
+
+// Validate interval settings
+func (settings *IntervalSettings) Validate() error {
+	if settings == nil || settings.Period == 0 {
+		return nil
+	}
+	if settings.Period < 0 {
+		return errors.Errorf("negative interval period<%v>", time.Duration(settings.Period))
+	}
+	if _, err := intervalFormatEnum.String(int(settings.Format)); err != nil {
+		return errors.Errorf("unknown interval format<%d>", settings.Format)
+	}
+	return nil
+}
+
+// startIntervalStatistics starts writing interval statistics, the returned function stops the interval writer,
+// writes the last (partial) interval and closes the file.
+func startIntervalStatistics(ctx context.Context, settings IntervalSettings, templateData interface{}, counters *statistics.ExecutionCounters) (func() error, error) {
+	if !settings.Enabled() {
+		return func() error { return nil }, nil
+	}
+
+	filename, err := settings.FileName.ExecuteString(templateData)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to expand session variables in interval filename")
+	}
+	if filename == "" {
+		filename = DefaultIntervalFilename
+	}
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create interval file<%s>", filename)
+	}
+
+	writer := &intervalWriter{
+		format:    settings.Format,
+		file:      file,
+		buf:       bufio.NewWriter(file),
+		collector: statistics.NewIntervalCollector(counters, time.Now()),
+	}
+	writer.csv = csv.NewWriter(writer.buf)
+	if err := writer.writeHeader(); err != nil {
+		_ = file.Close()
+		return nil, errors.WithStack(err)
+	}
+
+	stopChan := make(chan struct{})
+	done := make(chan struct{})
+	var writeErr error
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(time.Duration(settings.Period))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-stopChan:
+				return
+			case now := <-ticker.C:
+				if err := writer.write(now); err != nil {
+					writeErr = err
+					return
+				}
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() error {
+		once.Do(func() {
+			close(stopChan)
+			<-done
+			if writeErr == nil {
+				writeErr = writer.write(time.Now())
+			}
+			if err := writer.close(); err != nil && writeErr == nil {
+				writeErr = err
+			}
+		})
+		return writeErr
+	}, nil
+}
+
+func (writer *intervalWriter) writeHeader() error {
+	if writer.format != IntervalFormatCSV {
+		return nil
+	}
+	return errors.WithStack(writer.csv.Write(intervalCSVHeader))
+}
+
+func (writer *intervalWriter) write(now time.Time) error {
+	for _, entry := range writer.collector.Collect(now) {
+		switch writer.format {
+		case IntervalFormatJSONL:
+			jsn, err := json.Marshal(entry)
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal interval entry")
+			}
+			if _, err := writer.buf.Write(jsn); err != nil {
+				return errors.WithStack(err)
+			}
+			if err := writer.buf.WriteByte('\n'); err != nil {
+				return errors.WithStack(err)
+			}
+		default:
+			if err := writer.csv.Write(intervalCSVRow(&entry)); err != nil {
+				return errors.WithStack(err)
+			}
+		}
+	}
+	// flush each interval to make file usable during execution
+	writer.csv.Flush()
+	if err := writer.csv.Error(); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(writer.buf.Flush())
+}
+
+func (writer *intervalWriter) close() error {
+	flushErr := writer.buf.Flush()
+	if err := writer.file.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(flushErr)
+}
+
+func intervalCSVRow(entry *statistics.IntervalEntry) []string {
+	u := func(v uint64) string { return strconv.FormatUint(v, 10) }
+	f := func(v float64) string { return strconv.FormatFloat(v, 'f', 3, 64) }
+	return []string{
+		entry.Timestamp.Format(time.RFC3339Nano), f(entry.Interval), entry.Type, entry.Action, entry.Label,
+		entry.AppGUID, u(entry.Actions), u(entry.Failed), u(entry.Errors), u(entry.Warnings), u(entry.Requests),
+		u(entry.Sent), u(entry.Received), u(entry.ActiveUsers), f(entry.Throughput), f(entry.AvgResp), u(entry.P50),
+		u(entry.P90), u(entry.P95), u(entry.P99), u(entry.Max),
+	}
+}
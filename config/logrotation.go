@@ -0,0 +1,35 @@
+package config
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/gopherciser/helpers"
+	"github.com/qlik-oss/gopherciser/logger"
+)
+
+type (
+	// LogRotationSettings settings for splitting log file into segments
+	LogRotationSettings struct {
+		MaxSize     int64                  `json:"maxsize,omitempty" displayname:"Max segment size (MB)" doc-key:"config.settings.logs.rotation.maxsize"`
+		Interval    helpers.TimeDuration   `json:"interval,omitempty" displayname:"Segment interval" doc-key:"config.settings.logs.rotation.interval"`
+		Compression logger.CompressionType `json:"compression,omitempty" displayname:"Segment compression" doc-key:"config.settings.logs.rotation.compression"`
+		Retention   int                    `json:"retention,omitempty" displayname:"Segments kept" doc-key:"config.settings.logs.rotation.retention"`
+	}
+)
+
+// Validate log rotation settings
+func (settings LogRotationSettings) Validate() error {
+	return errors.WithStack(settings.writerSettings(false).Validate())
+}
+
+// writerSettings converts settings to rotation settings of logger.Writer
+func (settings LogRotationSettings) writerSettings(repeatHeader bool) logger.RotationSettings {
+	return logger.RotationSettings{
+		MaxSize:      settings.MaxSize * 1024 * 1024,
+		Interval:     time.Duration(settings.Interval),
+		Compression:  settings.Compression,
+		Retention:    settings.Retention,
+		RepeatHeader: repeatHeader,
+	}
+}
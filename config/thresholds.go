@@ -0,0 +1,351 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/gopherciser/enummap"
+	"github.com/qlik-oss/gopherciser/helpers"
+	"github.com/qlik-oss/gopherciser/logger"
+	"github.com/qlik-oss/gopherciser/statistics"
+)
+
+type (
+	ThresholdType int
+
+	// Threshold pass/fail rule evaluated against execution statistics after execution
+	Threshold struct {
+		Type       ThresholdType `json:"type" doc-key:"config.thresholds.type" displayname:"Type"`
+		Action     string        `json:"action,omitempty" doc-key:"config.thresholds.action" displayname:"Action"`
+		Label      string        `json:"label,omitempty" doc-key:"config.thresholds.label" displayname:"Label"`
+		Percentile float64       `json:"percentile,omitempty" doc-key:"config.thresholds.percentile" displayname:"Percentile"`
+		Limit      string        `json:"limit" doc-key:"config.thresholds.limit" displayname:"Limit"`
+
+		limit float64 // GUI can't handle multi type values, convert Limit string and put here
+	}
+
+	// Thresholds list of thresholds
+	Thresholds []Threshold
+
+	// ThresholdResult outcome of evaluating a threshold
+	ThresholdResult struct {
+		Threshold *Threshold
+		Value     float64
+		Passed    bool
+		// Samples amount of actions, requests etc. the value was calculated from
+		Samples uint64
+	}
+
+	// ThresholdsError one or more thresholds failed
+	ThresholdsError struct {
+		Failed []ThresholdResult
+	}
+)
+
+// ThresholdType enum
+const (
+	// ThresholdTypeResponseTime percentile response time of successful actions must be lower than limit
+	ThresholdTypeResponseTime ThresholdType = iota
+	// ThresholdTypeErrorRate percentage of failed actions must be lower than limit
+	ThresholdTypeErrorRate
+	// ThresholdTypeErrors amount of errors must be lower than limit
+	ThresholdTypeErrors
+	// ThresholdTypeWarnings amount of warnings must be lower than limit
+	ThresholdTypeWarnings
+	// ThresholdTypeRequestRate requests per second must be higher than limit
+	ThresholdTypeRequestRate
+)
+
+// DefaultThresholdPercentile used for response time thresholds if no percentile is defined
+const DefaultThresholdPercentile = 95.0
+
+var thresholdTypeEnum = enummap.NewEnumMapOrPanic(map[string]int{
+	"responsetime": int(ThresholdTypeResponseTime),
+	"errorrate":    int(ThresholdTypeErrorRate),
+	"errors":       int(ThresholdTypeErrors),
+	"warnings":     int(ThresholdTypeWarnings),
+	"requestrate":  int(ThresholdTypeRequestRate),
+})
+
+// GetEnumMap of ThresholdType for GUI
+func (typ ThresholdType) GetEnumMap() *enummap.EnumMap {
+	return thresholdTypeEnum
+}
+
+// UnmarshalJSON ThresholdType
+func (typ *ThresholdType) UnmarshalJSON(arg []byte) error {
+	i, err := thresholdTypeEnum.UnMarshal(arg)
+	if err != nil {
+		return errors.Wrap(err, "Failed to unmarshal ThresholdType")
+	}
+	*typ = ThresholdType(i)
+	return nil
+}
+
+// MarshalJSON marshal ThresholdType
+func (typ ThresholdType) MarshalJSON() ([]byte, error) {
+	str, err := thresholdTypeEnum.String(int(typ))
+	if err != nil {
+		return nil, errors.Errorf("Unknown ThresholdType<%d>", typ)
+	}
+	return []byte(fmt.Sprintf(`"%s"`, str)), nil
+}
+
+// Error implementation of Error interface
+func (err ThresholdsError) Error() string {
+	descriptions := make([]string, 0, len(err.Failed))
+	for _, result := range err.Failed {
+		descriptions = append(descriptions, result.String())
+	}
+	return fmt.Sprintf("%d threshold(s) failed:\n%s", len(err.Failed), strings.Join(descriptions, "\n"))
+}
+
+// Validate thresholds, returns list of warnings or error
+func (thresholds Thresholds) Validate() ([]string, error) {
+	var warnings []string
+	for i := range thresholds {
+		w, err := thresholds[i].Validate()
+		if err != nil {
+			return warnings, errors.Wrapf(err, "threshold<%d> validation failed", i)
+		}
+		warnings = append(warnings, w...)
+	}
+	return warnings, nil
+}
+
+// Validate threshold settings and convert limit, returns list of warnings or error
+func (threshold *Threshold) Validate() ([]string, error) {
+	if _, err := thresholdTypeEnum.String(int(threshold.Type)); err != nil {
+		return nil, errors.Errorf("unknown threshold type<%d>", threshold.Type)
+	}
+
+	if threshold.Limit == "" {
+		return nil, errors.New("threshold has no limit defined")
+	}
+
+	var warnings []string
+	switch threshold.Type {
+	case ThresholdTypeResponseTime:
+		limit, err := time.ParseDuration(threshold.Limit)
+		if err != nil {
+			return nil, errors.Errorf("threshold limit<%s> is not a duration", threshold.Limit)
+		}
+		threshold.limit = float64(limit)
+		if threshold.Percentile < 0 || threshold.Percentile > 100 {
+			return nil, errors.Errorf("threshold percentile<%v> not within 0-100", threshold.Percentile)
+		}
+		if threshold.Percentile == 0 {
+			threshold.Percentile = DefaultThresholdPercentile
+		}
+	default:
+		limit, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(threshold.Limit), "%"), 64)
+		if err != nil {
+			return nil, errors.Errorf("threshold limit<%s> is not a number", threshold.Limit)
+		}
+		threshold.limit = limit
+		if threshold.Percentile != 0 {
+			warnings = append(warnings, fmt.Sprintf("percentile is only used by threshold type responsetime, ignored for threshold<%s>", threshold))
+		}
+	}
+
+	if threshold.Type == ThresholdTypeRequestRate && (threshold.Action != "" || threshold.Label != "") {
+		warnings = append(warnings, fmt.Sprintf("action and label filters not used by threshold type requestrate, ignored for threshold<%s>", threshold))
+	}
+
+	return warnings, nil
+}
+
+// String human readable description of threshold
+func (threshold *Threshold) String() string {
+	buf := helpers.NewBuffer()
+	if threshold.Action != "" {
+		buf.WriteString(threshold.Action)
+		buf.WriteString(" ")
+	}
+	if threshold.Label != "" {
+		buf.WriteString("(")
+		buf.WriteString(threshold.Label)
+		buf.WriteString(") ")
+	}
+
+	switch threshold.Type {
+	case ThresholdTypeResponseTime:
+		buf.WriteString("p")
+		buf.WriteString(strconv.FormatFloat(threshold.Percentile, 'f', -1, 64))
+		buf.WriteString(" < ")
+	case ThresholdTypeErrorRate:
+		buf.WriteString("error rate < ")
+	case ThresholdTypeErrors:
+		buf.WriteString("errors < ")
+	case ThresholdTypeWarnings:
+		buf.WriteString("warnings < ")
+	case ThresholdTypeRequestRate:
+		buf.WriteString("requests/s > ")
+	}
+	buf.WriteString(threshold.Limit)
+	return buf.String()
+}
+
+// String human readable result of threshold evaluation
+func (result ThresholdResult) String() string {
+	outcome := "PASS"
+	if !result.Passed {
+		outcome = "FAIL"
+	}
+	return fmt.Sprintf("%s: %s (actual: %s)", outcome, result.Threshold, result.ValueString())
+}
+
+// ValueString formats evaluated value according to threshold type
+func (result ThresholdResult) ValueString() string {
+	switch result.Threshold.Type {
+	case ThresholdTypeResponseTime:
+		if result.Samples < 1 {
+			return "no successful actions"
+		}
+		return durationString(result.Value)
+	case ThresholdTypeErrorRate:
+		return fmt.Sprintf("%.2f%%", result.Value)
+	case ThresholdTypeRequestRate:
+		return fmt.Sprintf("%.2f", result.Value)
+	default:
+		return strconv.FormatFloat(result.Value, 'f', -1, 64)
+	}
+}
+
+// matches action statistics entry
+func (threshold *Threshold) matches(stats *statistics.ActionStats) bool {
+	if threshold.Action != "" && threshold.Action != stats.Name() {
+		return false
+	}
+	if threshold.Label != "" && threshold.Label != stats.Label() {
+		return false
+	}
+	return true
+}
+
+// filtered returns true if threshold is limited to specific actions or labels
+func (threshold *Threshold) filtered() bool {
+	return threshold.Action != "" || threshold.Label != ""
+}
+
+// Evaluate threshold against execution counters
+func (threshold *Threshold) Evaluate(counters *statistics.ExecutionCounters, duration time.Duration) ThresholdResult {
+	result := ThresholdResult{Threshold: threshold}
+
+	var successful, failed, errs, warnings uint64
+	hist := statistics.NewHistogram()
+	counters.StatisticsCollector.ForEachAction(func(stats *statistics.ActionStats) {
+		if !threshold.matches(stats) {
+			return
+		}
+		actionHist := stats.RespAvg.Histogram()
+		hist.Merge(actionHist)
+		successful += actionHist.Count()
+		failed += stats.Failed.Current()
+		errs += stats.ErrCount.Current()
+		warnings += stats.WarnCount.Current()
+	})
+
+	switch threshold.Type {
+	case ThresholdTypeResponseTime:
+		result.Samples = hist.Count()
+		result.Value = float64(hist.Percentile(threshold.Percentile))
+		result.Passed = result.Samples > 0 && result.Value < threshold.limit
+	case ThresholdTypeErrorRate:
+		result.Samples = successful + failed
+		if result.Samples > 0 {
+			result.Value = float64(failed) / float64(result.Samples) * 100
+		}
+		result.Passed = result.Value < threshold.limit
+	case ThresholdTypeErrors:
+		if !threshold.filtered() {
+			errs = counters.Errors.Current()
+		}
+		result.Samples = errs
+		result.Value = float64(errs)
+		result.Passed = result.Value < threshold.limit
+	case ThresholdTypeWarnings:
+		if !threshold.filtered() {
+			warnings = counters.Warnings.Current()
+		}
+		result.Samples = warnings
+		result.Value = float64(warnings)
+		result.Passed = result.Value < threshold.limit
+	case ThresholdTypeRequestRate:
+		result.Samples = counters.Requests.Current()
+		if duration > 0 {
+			result.Value = float64(result.Samples) / duration.Seconds()
+		}
+		result.Passed = result.Value > threshold.limit
+	}
+
+	return result
+}
+
+// Evaluate all thresholds, returns ThresholdsError if any threshold failed
+func (thresholds Thresholds) Evaluate(counters *statistics.ExecutionCounters, duration time.Duration) ([]ThresholdResult, error) {
+	if len(thresholds) < 1 {
+		return nil, nil
+	}
+
+	// make sure limits are converted
+	if _, err := thresholds.Validate(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	results := make([]ThresholdResult, 0, len(thresholds))
+	var failed []ThresholdResult
+	for i := range thresholds {
+		result := thresholds[i].Evaluate(counters, duration)
+		results = append(results, result)
+		if !result.Passed {
+			failed = append(failed, result)
+		}
+	}
+
+	if len(failed) > 0 {
+		return results, ThresholdsError{Failed: failed}
+	}
+	return results, nil
+}
+
+// thresholdReport logs threshold results and prints report to console
+func thresholdReport(log *logger.Log, summary SummaryType, results []ThresholdResult) {
+	if len(results) < 1 {
+		return
+	}
+
+	entry := logger.NewLogEntry(log)
+	for _, result := range results {
+		entry.LogInfo("ThresholdResult", result.String())
+	}
+
+	if summary == SummaryTypeNone {
+		return
+	}
+
+	buf := helpers.NewBuffer()
+	buf.WriteString(ansiBoldBlue)
+	buf.WriteString("Thresholds:\n")
+	buf.WriteString(ansiReset)
+	for _, result := range results {
+		if result.Passed {
+			buf.WriteString(ansiBoldBlue)
+		} else {
+			buf.WriteString(ansiBoldRed)
+		}
+		buf.WriteString(result.String())
+		buf.WriteString(ansiReset)
+		buf.WriteString("\n")
+	}
+	buf.WriteString("\n")
+
+	if buf.Error != nil {
+		_, _ = fmt.Fprintln(ansiWriter, "failed to write threshold report:", buf.Error)
+		return
+	}
+	buf.WriteTo(ansiWriter)
+}
@@ -0,0 +1,84 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/gopherciser/statistics"
+)
+
+func TestThresholds(t *testing.T) {
+	raw := []byte(`[
+		{ "type": "responsetime", "action": "openapp", "percentile": 95, "limit": "5s" },
+		{ "type": "responsetime", "action": "changesheet", "limit": "100ms" },
+		{ "type": "errorrate", "action": "changesheet", "limit": "1%" },
+		{ "type": "errors", "limit": "10" },
+		{ "type": "requestrate", "limit": "1" }
+	]`)
+
+	var thresholds Thresholds
+	if err := json.Unmarshal(raw, &thresholds); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := thresholds.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if thresholds[1].Percentile != DefaultThresholdPercentile {
+		t.Errorf("percentile<%v> expected default<%v>", thresholds[1].Percentile, DefaultThresholdPercentile)
+	}
+
+	counters := &statistics.ExecutionCounters{StatisticsCollector: statistics.NewCollector()}
+	if err := counters.StatisticsCollector.SetLevel(statistics.StatsLevelOn); err != nil {
+		t.Fatal(err)
+	}
+	counters.Errors.Add(3)
+	counters.Requests.Add(100)
+
+	openStats := counters.StatisticsCollector.GetOrAddActionStats("openapp", "", "app1")
+	for i := 0; i < 100; i++ {
+		openStats.RespAvg.AddSample(uint64(time.Second))
+	}
+	chStats := counters.StatisticsCollector.GetOrAddActionStats("changesheet", "", "app1")
+	for i := 0; i < 98; i++ {
+		chStats.RespAvg.AddSample(uint64(time.Millisecond * 500))
+	}
+	chStats.Failed.Add(2)
+
+	results, err := thresholds.Evaluate(counters, 10*time.Second)
+	if len(results) != len(thresholds) {
+		t.Fatalf("got %d results, expected %d", len(results), len(thresholds))
+	}
+
+	expected := []bool{true, false, false, true, true}
+	for i, result := range results {
+		if result.Passed != expected[i] {
+			t.Errorf("threshold<%s> passed<%v> expected<%v>", result.Threshold, result.Passed, expected[i])
+		}
+	}
+
+	var thresholdsErr ThresholdsError
+	if !errors.As(err, &thresholdsErr) {
+		t.Fatalf("expected ThresholdsError, got %v", err)
+	}
+	if len(thresholdsErr.Failed) != 2 {
+		t.Errorf("got %d failed thresholds, expected 2", len(thresholdsErr.Failed))
+	}
+}
+
+func TestThresholdValidate(t *testing.T) {
+	invalid := []Threshold{
+		{Type: ThresholdTypeResponseTime, Limit: "10"},
+		{Type: ThresholdTypeResponseTime, Limit: "1s", Percentile: 101},
+		{Type: ThresholdTypeErrors, Limit: "many"},
+		{Type: ThresholdTypeErrors},
+		{Type: ThresholdType(99), Limit: "1"},
+	}
+
+	for _, threshold := range invalid {
+		if _, err := threshold.Validate(); err == nil {
+			t.Errorf("threshold<%+v> expected validation error", threshold)
+		}
+	}
+}
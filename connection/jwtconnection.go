@@ -69,6 +69,10 @@ func (connectJWT *ConnectJWTSettings) GetConnectFunc(sessionState *session.State
 		}
 
 		sense := enigmahandlers.NewSenseUplink(sessionState.BaseContext(), sessionState.LogEntry, sessionState.RequestMetrics, sessionState.TrafficLogger(), connectionSettings.MaxFrameSize)
+		sense.Trace = sessionState.Trace
+		if sessionState.Counters != nil {
+			sense.Statistics = sessionState.Counters.StatisticsCollector
+		}
 		sessionState.Connection.SetSense(sense)
 
 		// Connect
@@ -30,6 +30,10 @@ func (connectWs *ConnectWsSettings) GetConnectFunc(sessionState *session.State,
 		}
 
 		sense := enigmahandlers.NewSenseUplink(sessionState.BaseContext(), sessionState.LogEntry, sessionState.RequestMetrics, sessionState.TrafficLogger(), connectionSettings.MaxFrameSize)
+		sense.Trace = sessionState.Trace
+		if sessionState.Counters != nil {
+			sense.Statistics = sessionState.Counters.StatisticsCollector
+		}
 		sessionState.Connection.SetSense(sense)
 
 		url, err := connectionSettings.EngineUrl(appGUID, externalhost)
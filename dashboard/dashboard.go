@@ -0,0 +1,409 @@
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/qlik-oss/gopherciser/helpers"
+	"github.com/qlik-oss/gopherciser/logger"
+	"github.com/qlik-oss/gopherciser/statistics"
+	"github.com/qlik-oss/gopherciser/version"
+)
+
+type (
+	// Dashboard live full-screen terminal view of an execution
+	Dashboard struct {
+		counters    *statistics.ExecutionCounters
+		targetUsers int
+		width       int
+		start       time.Time
+
+		mu      sync.Mutex
+		last    time.Time
+		actions map[string]*actionHistory
+		issues  []issue
+	}
+
+	// actionHistory throughput and p95 history of an action and label, merged over apps
+	actionHistory struct {
+		action     string
+		label      string
+		successful uint64
+		failed     uint64
+		prev       *statistics.Histogram
+		prevFailed uint64
+		throughput []float64
+		p95        []float64
+	}
+
+	// issue error or warning captured from log
+	issue struct {
+		time    time.Time
+		level   logger.LogLevel
+		action  string
+		message string
+	}
+
+	// issueWriter logger.MsgWriter capturing errors and warnings to dashboard
+	issueWriter struct {
+		dashboard *Dashboard
+	}
+)
+
+const (
+	// DefaultInterval default refresh interval of dashboard
+	DefaultInterval = time.Second
+
+	historyLength = 30
+	maxIssues     = 10
+	maxApps       = 10
+	defaultWidth  = 120
+
+	ansiReset      = "\x1b[0m"
+	ansiBold       = "\x1b[1m"
+	ansiRed        = "\x1b[31m"
+	ansiYellow     = "\x1b[33m"
+	ansiGreen      = "\x1b[32m"
+	ansiDim        = "\x1b[2m"
+	ansiClear      = "\x1b[H\x1b[2J"
+	ansiAltScreen  = "\x1b[?1049h\x1b[?25l"
+	ansiMainScreen = "\x1b[?25h\x1b[?1049l"
+)
+
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// New dashboard showing counters, targetUsers is the amount of concurrent users expected by the scheduler, 0 if
+// unknown. Width of dashboard is read from COLUMNS environment variable if set.
+func New(counters *statistics.ExecutionCounters, targetUsers int) *Dashboard {
+	width := defaultWidth
+	if columns, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && columns > 40 {
+		width = columns
+	}
+	return &Dashboard{
+		counters:    counters,
+		targetUsers: targetUsers,
+		width:       width,
+		start:       time.Now(),
+		actions:     make(map[string]*actionHistory),
+	}
+}
+
+// Logger capturing errors and warnings to be shown on dashboard
+func (dashboard *Dashboard) Logger() *logger.Logger {
+	return logger.NewLogger(&issueWriter{dashboard: dashboard})
+}
+
+// Start rendering dashboard to w every interval on alternate screen. Returned function stops rendering and restores
+// the main screen.
+func (dashboard *Dashboard) Start(ctx context.Context, w io.Writer, interval time.Duration) func() {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		_, _ = io.WriteString(w, ansiAltScreen)
+		defer func() {
+			_, _ = io.WriteString(w, ansiMainScreen)
+		}()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			dashboard.Update(time.Now())
+			buf := helpers.NewBuffer()
+			buf.WriteString(ansiClear)
+			dashboard.Render(buf, time.Now())
+			if buf.Error == nil {
+				buf.WriteTo(w)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// Update action history with statistics collected since last update
+func (dashboard *Dashboard) Update(now time.Time) {
+	dashboard.mu.Lock()
+	defer dashboard.mu.Unlock()
+
+	seconds := 0.0
+	if !dashboard.last.IsZero() {
+		seconds = now.Sub(dashboard.last).Seconds()
+	}
+	dashboard.last = now
+
+	type current struct {
+		hist   *statistics.Histogram
+		failed uint64
+	}
+	currents := make(map[string]*current)
+	dashboard.counters.StatisticsCollector.ForEachAction(func(stats *statistics.ActionStats) {
+		key := stats.Name() + "\x00" + stats.Label()
+		cur, ok := currents[key]
+		if !ok {
+			cur = &current{hist: statistics.NewHistogram()}
+			currents[key] = cur
+		}
+		cur.hist.Merge(stats.RespAvg.Histogram())
+		cur.failed += stats.Failed.Current()
+
+		if _, ok := dashboard.actions[key]; !ok {
+			dashboard.actions[key] = &actionHistory{action: stats.Name(), label: stats.Label()}
+		}
+	})
+
+	for key, cur := range currents {
+		history := dashboard.actions[key]
+		delta := cur.hist.Sub(history.prev)
+		actions := float64(delta.Count() + cur.failed - history.prevFailed)
+		if seconds > 0 {
+			history.throughput = appendHistory(history.throughput, actions/seconds)
+			history.p95 = appendHistory(history.p95, float64(delta.Percentile(95)))
+		}
+		history.prev = cur.hist
+		history.prevFailed = cur.failed
+		history.successful = cur.hist.Count()
+		history.failed = cur.failed
+	}
+}
+
+func appendHistory(history []float64, v float64) []float64 {
+	history = append(history, v)
+	if len(history) > historyLength {
+		history = history[len(history)-historyLength:]
+	}
+	return history
+}
+
+// Render dashboard frame to buf
+func (dashboard *Dashboard) Render(buf *helpers.Buffer, now time.Time) {
+	dashboard.mu.Lock()
+	defer dashboard.mu.Unlock()
+
+	counters := dashboard.counters
+
+	// Header
+	elapsed := now.Sub(dashboard.start).Truncate(time.Second)
+	buf.WriteString(fmt.Sprintf("%sGopherciser %s%s  Elapsed %s  %s\n\n", ansiBold, version.Version, ansiReset, elapsed, now.Format(time.RFC3339)))
+
+	active := counters.ActiveUsers.Current()
+	if dashboard.targetUsers > 0 {
+		buf.WriteString(fmt.Sprintf("Users      %d / %d %s\n", active, dashboard.targetUsers, progressBar(float64(active)/float64(dashboard.targetUsers), 30)))
+	} else {
+		buf.WriteString(fmt.Sprintf("Users      %d\n", active))
+	}
+
+	errColor, warnColor := "", ""
+	if counters.Errors.Current() > 0 {
+		errColor = ansiRed
+	}
+	if counters.Warnings.Current() > 0 {
+		warnColor = ansiYellow
+	}
+	buf.WriteString(fmt.Sprintf("Sessions   %d  Actions %d  Requests %d  %sErrors %d%s  %sWarnings %d%s\n\n",
+		counters.Sessions.Current(), counters.ActionID.Current(), counters.Requests.Current(),
+		errColor, counters.Errors.Current(), ansiReset, warnColor, counters.Warnings.Current(), ansiReset))
+
+	dashboard.renderActions(buf)
+	dashboard.renderIssues(buf)
+	dashboard.renderApps(buf)
+}
+
+func (dashboard *Dashboard) renderActions(buf *helpers.Buffer) {
+	histories := make([]*actionHistory, 0, len(dashboard.actions))
+	nameWidth := len("Action")
+	for _, history := range dashboard.actions {
+		histories = append(histories, history)
+		nameWidth = max(nameWidth, len(history.name()))
+	}
+	sort.Slice(histories, func(i, j int) bool {
+		return histories[i].name() < histories[j].name()
+	})
+	nameWidth = min(nameWidth, dashboard.width/3)
+
+	sparkWidth := historyLength
+	buf.WriteString(fmt.Sprintf("%s%-*s %8s %8s %8s  %-*s %9s  %-*s%s\n", ansiBold, nameWidth, "Action", "OK", "Failed", "Act/s",
+		sparkWidth, "Throughput", "p95", sparkWidth, "p95 trend", ansiReset))
+	if len(histories) < 1 {
+		buf.WriteString(ansiDim + "No actions yet" + ansiReset + "\n")
+	}
+	for _, history := range histories {
+		failedColor := ""
+		if history.failed > 0 {
+			failedColor = ansiRed
+		}
+		buf.WriteString(fmt.Sprintf("%-*s %8d %s%8d%s %8s  %s%s%s %9s  %s%s%s\n",
+			nameWidth, truncate(history.name(), nameWidth), history.successful, failedColor, history.failed, ansiReset,
+			formatRate(last(history.throughput)),
+			ansiGreen, sparkline(history.throughput, sparkWidth), ansiReset,
+			formatDuration(last(history.p95)),
+			ansiYellow, sparkline(history.p95, sparkWidth), ansiReset))
+	}
+	buf.WriteString("\n")
+}
+
+func (dashboard *Dashboard) renderIssues(buf *helpers.Buffer) {
+	buf.WriteString(ansiBold + "Recent errors and warnings" + ansiReset + "\n")
+	if len(dashboard.issues) < 1 {
+		buf.WriteString(ansiDim + "None" + ansiReset + "\n")
+	}
+	for i := len(dashboard.issues) - 1; i >= 0; i-- {
+		issue := dashboard.issues[i]
+		color := ansiYellow
+		if issue.level == logger.ErrorLevel {
+			color = ansiRed
+		}
+		line := fmt.Sprintf("%s %-7s %-20s %s", issue.time.Format("15:04:05"), issue.level, truncate(issue.action, 20), issue.message)
+		buf.WriteString(color + truncate(line, dashboard.width) + ansiReset + "\n")
+	}
+	buf.WriteString("\n")
+}
+
+func (dashboard *Dashboard) renderApps(buf *helpers.Buffer) {
+	opened := make(map[string]uint64)
+	dashboard.counters.StatisticsCollector.ForEachAction(func(stats *statistics.ActionStats) {
+		if stats.Name() != "openapp" || stats.AppGUID() == "" {
+			return
+		}
+		opened[stats.AppGUID()] += stats.RespAvg.Histogram().Count()
+	})
+
+	apps := make([]string, 0, len(opened))
+	for app := range opened {
+		apps = append(apps, app)
+	}
+	sort.Slice(apps, func(i, j int) bool {
+		if opened[apps[i]] == opened[apps[j]] {
+			return apps[i] < apps[j]
+		}
+		return opened[apps[i]] > opened[apps[j]]
+	})
+
+	buf.WriteString(fmt.Sprintf("%sApps opened (total %d)%s\n", ansiBold, dashboard.counters.StatisticsCollector.OpenedApps(), ansiReset))
+	if len(apps) < 1 {
+		buf.WriteString(ansiDim + "None" + ansiReset + "\n")
+	}
+	for i, app := range apps {
+		if i >= maxApps {
+			buf.WriteString(fmt.Sprintf("%s... and %d more%s\n", ansiDim, len(apps)-maxApps, ansiReset))
+			break
+		}
+		buf.WriteString(fmt.Sprintf("%-40s %8d\n", app, opened[app]))
+	}
+}
+
+func (history *actionHistory) name() string {
+	if history.label == "" {
+		return history.action
+	}
+	return history.action + " (" + history.label + ")"
+}
+
+// addIssue keeps the latest maxIssues errors and warnings
+func (dashboard *Dashboard) addIssue(msg *logger.LogChanMsg) {
+	message := msg.Message
+	if msg.Details != "" {
+		message += ": " + msg.Details
+	}
+	message = strings.Join(strings.Fields(message), " ")
+
+	dashboard.mu.Lock()
+	defer dashboard.mu.Unlock()
+	dashboard.issues = append(dashboard.issues, issue{
+		time:    msg.Time,
+		level:   msg.Level,
+		action:  msg.Action,
+		message: message,
+	})
+	if len(dashboard.issues) > maxIssues {
+		dashboard.issues = dashboard.issues[len(dashboard.issues)-maxIssues:]
+	}
+}
+
+// WriteMessage implement logger.MsgWriter interface
+func (writer *issueWriter) WriteMessage(msg *logger.LogChanMsg) error {
+	if msg == nil || (msg.Level != logger.ErrorLevel && msg.Level != logger.WarningLevel) {
+		return nil
+	}
+	writer.dashboard.addIssue(msg)
+	return nil
+}
+
+// Level implement logger.MsgWriter interface
+func (writer *issueWriter) Level(lvl logger.LogLevel) {}
+
+// sparkline renders values scaled to max value as unicode block characters, padded with spaces to width
+func sparkline(values []float64, width int) string {
+	maxValue := 0.0
+	for _, v := range values {
+		maxValue = max(maxValue, v)
+	}
+	var line strings.Builder
+	for _, v := range values {
+		idx := 0
+		if maxValue > 0 {
+			idx = int(v / maxValue * float64(len(sparkBlocks)-1))
+		}
+		line.WriteRune(sparkBlocks[idx])
+	}
+	if len(values) < width {
+		line.WriteString(strings.Repeat(" ", width-len(values)))
+	}
+	return line.String()
+}
+
+func progressBar(ratio float64, width int) string {
+	ratio = min(max(ratio, 0), 1)
+	filled := int(ratio * float64(width))
+	return "[" + strings.Repeat("#", filled) + strings.Repeat("-", width-filled) + "]"
+}
+
+func truncate(s string, width int) string {
+	if len(s) <= width || width < 4 {
+		return s
+	}
+	return s[:width-3] + "..."
+}
+
+func last(values []float64) float64 {
+	if len(values) < 1 {
+		return 0
+	}
+	return values[len(values)-1]
+}
+
+func formatRate(v float64) string {
+	return strconv.FormatFloat(v, 'f', 1, 64)
+}
+
+func formatDuration(ns float64) string {
+	if ns <= 0 {
+		return "-"
+	}
+	d := time.Duration(ns)
+	switch {
+	case d >= time.Second:
+		return d.Round(10 * time.Millisecond).String()
+	case d >= time.Millisecond:
+		return d.Round(time.Millisecond).String()
+	default:
+		return d.Round(time.Microsecond).String()
+	}
+}
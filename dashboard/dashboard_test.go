@@ -0,0 +1,100 @@
+package dashboard
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/qlik-oss/gopherciser/helpers"
+	"github.com/qlik-oss/gopherciser/logger"
+	"github.com/qlik-oss/gopherciser/statistics"
+)
+
+func TestDashboard(t *testing.T) {
+	counters := &statistics.ExecutionCounters{StatisticsCollector: statistics.NewCollector()}
+	if err := counters.StatisticsCollector.SetLevel(statistics.StatsLevelOn); err != nil {
+		t.Fatal(err)
+	}
+	counters.ActiveUsers.Add(5)
+
+	dashboard := New(counters, 10)
+	start := time.Now()
+	dashboard.Update(start)
+
+	// openapp in two different apps should be merged
+	for _, app := range []string{"app1", "app2"} {
+		stats := counters.StatisticsCollector.GetOrAddActionStats("openapp", "", app)
+		stats.RespAvg.AddSample(uint64(100 * time.Millisecond))
+		stats.RespAvg.AddSample(uint64(200 * time.Millisecond))
+	}
+	counters.StatisticsCollector.GetOrAddActionStats("openapp", "", "app1").Failed.Inc()
+	dashboard.Update(start.Add(time.Second))
+
+	msg := logger.NewEmptyLogChanMsg()
+	msg.Level = logger.ErrorLevel
+	msg.Time = start
+	msg.Action = "openapp"
+	msg.Message = "failed to open app"
+	msg.Details = "app not found"
+	writer := dashboard.Logger().Writer
+	if err := writer.WriteMessage(msg); err != nil {
+		t.Fatal(err)
+	}
+	info := logger.NewEmptyLogChanMsg()
+	info.Level = logger.InfoLevel
+	info.Message = "info should not be shown"
+	if err := writer.WriteMessage(info); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := helpers.NewBuffer()
+	dashboard.Render(buf, start.Add(time.Second))
+	if buf.Error != nil {
+		t.Fatal(buf.Error)
+	}
+	frame := buf.String()
+
+	for _, expected := range []string{
+		"5 / 10 [###############---------------]",
+		"failed to open app: app not found",
+		"Apps opened",
+		"app1",
+		"app2",
+	} {
+		if !strings.Contains(frame, expected) {
+			t.Errorf("expected dashboard to contain<%s>:\n%s", expected, frame)
+		}
+	}
+	if strings.Contains(frame, "info should not be shown") {
+		t.Error("info messages should not be shown on dashboard")
+	}
+
+	history := dashboard.actions["openapp\x00"]
+	if history == nil {
+		t.Fatal("no history for openapp")
+	}
+	if history.successful != 4 || history.failed != 1 {
+		t.Errorf("expected successful<4> failed<1>, got successful<%d> failed<%d>", history.successful, history.failed)
+	}
+	if len(history.throughput) != 1 || history.throughput[0] != 5 {
+		t.Errorf("expected throughput<[5]>, got<%v>", history.throughput)
+	}
+	if p95 := time.Duration(history.p95[0]); p95.Round(10*time.Millisecond) != 200*time.Millisecond {
+		t.Errorf("expected p95<200ms>, got<%v>", p95)
+	}
+
+	// no new samples gives zero throughput
+	dashboard.Update(start.Add(2 * time.Second))
+	if len(history.throughput) != 2 || history.throughput[1] != 0 {
+		t.Errorf("expected throughput<[5 0]>, got<%v>", history.throughput)
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	if line := sparkline([]float64{0, 1, 2, 4}, 6); line != "▁▂▄█  " {
+		t.Errorf("unexpected sparkline<%s>", line)
+	}
+	if line := sparkline([]float64{0, 0}, 2); line != "▁▁" {
+		t.Errorf("unexpected sparkline<%s>", line)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/goccy/go-json"
 	"github.com/pkg/errors"
 	"github.com/qlik-oss/enigma-go/v4"
+	"github.com/qlik-oss/gopherciser/buildmetrics"
 	"github.com/qlik-oss/gopherciser/enigmainterceptors"
 	"github.com/qlik-oss/gopherciser/globals"
 	"github.com/qlik-oss/gopherciser/globals/constant"
@@ -19,6 +20,8 @@ import (
 	"github.com/qlik-oss/gopherciser/logger"
 	"github.com/qlik-oss/gopherciser/requestmetrics"
 	"github.com/qlik-oss/gopherciser/senseobjects"
+	"github.com/qlik-oss/gopherciser/statistics"
+	"github.com/qlik-oss/gopherciser/tracing"
 )
 
 type (
@@ -31,6 +34,10 @@ type (
 		VarCache     VarCache
 		Traffic      ITrafficLogger
 		MaxFrameSize int64
+		// Trace of current session, nil when tracing is disabled
+		Trace *tracing.SessionTrace
+		// Statistics collector of engine method statistics, nil when statistics are not collected
+		Statistics *statistics.Collector
 
 		ctx               context.Context
 		cancel            context.CancelFunc
@@ -129,7 +136,8 @@ func (uplink *SenseUplink) Connect(ctx context.Context, url string, headers http
 		MockMode: uplink.MockMode,
 		Interceptors: []enigma.Interceptor{
 			(&enigmainterceptors.MetricsHandler{
-				Log: uplink.LogMetric,
+				Log:   uplink.LogMetric,
+				Trace: uplink.traceRequest,
 			}).MetricsInterceptor,
 			uplink.retryInterceptor,
 		},
@@ -151,6 +159,9 @@ func (uplink *SenseUplink) Connect(ctx context.Context, url string, headers http
 
 	setupDialer(&dialer, timeout, onUnexpectedDisconnect, uplink.MaxFrameSize)
 
+	// propagate trace of current action on websocket upgrade
+	headers = uplink.Trace.InjectHeaders(headers)
+
 	// TODO somehow get better values for connect time
 	startTimestamp := time.Now()
 	global, err := dialer.Dial(ctx, url, headers)
@@ -270,6 +281,36 @@ func (uplink *SenseUplink) executeFailedConnectFuncs() {
 	uplink.failedConnectFunc()
 }
 
+// traceRequest creates engine request span as child of current action when tracing is enabled
+func (uplink *SenseUplink) traceRequest(invocation *enigma.Invocation) func(metrics *enigma.InvocationMetrics, response *enigma.InvocationResponse) {
+	if uplink.Trace == nil || invocation == nil {
+		return nil
+	}
+
+	var objectID string
+	var handle int
+	if invocation.RemoteObject != nil && invocation.RemoteObject.ObjectInterface != nil {
+		objectID = invocation.RemoteObject.GenericId
+		handle = invocation.RemoteObject.Handle
+	}
+
+	end := uplink.Trace.StartEngineRequest(invocation.Method, objectID, handle)
+	if end == nil {
+		return nil
+	}
+	return func(metrics *enigma.InvocationMetrics, response *enigma.InvocationResponse) {
+		var err error
+		if response != nil {
+			err = response.Error
+		}
+		if metrics == nil {
+			end(time.Time{}, time.Time{}, 0, 0, err)
+			return
+		}
+		end(metrics.SocketWriteTimestamp, metrics.SocketReadTimestamp, metrics.RequestMessageSize, metrics.ResponseMessageSize, err)
+	}
+}
+
 // LogMetric async log metric, this is injected into the enigma dialer and is responsible for recording
 // message sent and received times, these times are used to record response times both for individual
 // requests and entire actions
@@ -283,6 +324,7 @@ func (uplink *SenseUplink) LogMetric(invocation *enigma.Invocation, metrics *eni
 	var params string
 	if invocation != nil {
 		method = invocation.Method
+		uplink.reportEngineMethod(invocation.Method, metrics, result)
 		if invocation.RemoteObject != nil && strings.TrimSpace(invocation.RemoteObject.GenericId) != "" {
 			buf := helpers.NewBuffer()
 			buf.WriteString(method)
@@ -322,6 +364,28 @@ func (uplink *SenseUplink) LogMetric(invocation *enigma.Invocation, metrics *eni
 
 }
 
+// reportEngineMethod adds request to engine method statistics and metrics
+func (uplink *SenseUplink) reportEngineMethod(method string, metrics *enigma.InvocationMetrics, result *enigma.InvocationResponse) {
+	if metrics.SocketWriteTimestamp.IsZero() || metrics.SocketReadTimestamp.IsZero() {
+		return // request was never sent or response never received
+	}
+	respTime := metrics.SocketReadTimestamp.Sub(metrics.SocketWriteTimestamp)
+	failed := result != nil && result.Error != nil
+
+	buildmetrics.ReportEngineRequest(method, failed, respTime)
+
+	stats := uplink.Statistics.GetOrAddEngineStats(method)
+	if stats == nil {
+		return
+	}
+	stats.RespAvg.AddSample(uint64(respTime.Nanoseconds()))
+	stats.Sent.Add(uint64(metrics.RequestMessageSize))
+	stats.Received.Add(uint64(metrics.ResponseMessageSize))
+	if failed {
+		stats.Errors.Inc()
+	}
+}
+
 func (uplink *SenseUplink) retryInterceptor(ctx context.Context, invocation *enigma.Invocation,
 	next enigma.InterceptorContinuation) *enigma.InvocationResponse {
 
@@ -4,9 +4,12 @@ import (
 	"context"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
+	"github.com/qlik-oss/enigma-go/v4"
 	"github.com/qlik-oss/gopherciser/requestmetrics"
+	"github.com/qlik-oss/gopherciser/statistics"
 )
 
 func TestConnection(t *testing.T) {
@@ -18,6 +21,49 @@ func TestConnection(t *testing.T) {
 	}
 }
 
+func TestEngineMethodStatistics(t *testing.T) {
+	connection := NewSenseUplink(context.Background(), nil, &requestmetrics.RequestMetrics{}, nil, 0)
+	connection.Statistics = statistics.NewCollector()
+	if err := connection.Statistics.SetLevel(statistics.StatsLevelOn); err != nil {
+		t.Fatal(err)
+	}
+
+	sent := time.Now()
+	for i, respTime := range []time.Duration{10 * time.Millisecond, 30 * time.Millisecond} {
+		metrics := &enigma.InvocationMetrics{
+			InvocationRequestTimestamp:  sent,
+			SocketWriteTimestamp:        sent,
+			SocketReadTimestamp:         sent.Add(respTime),
+			InvocationResponseTimestamp: sent.Add(respTime),
+			RequestMessageSize:          100,
+			ResponseMessageSize:         1000,
+		}
+		response := &enigma.InvocationResponse{RequestID: i}
+		if i > 0 {
+			response.Error = errors.New("engine error")
+		}
+		connection.LogMetric(&enigma.Invocation{Method: "GetLayout"}, metrics, response)
+	}
+
+	// request never sent should not be counted
+	connection.LogMetric(&enigma.Invocation{Method: "GetLayout"}, &enigma.InvocationMetrics{}, nil)
+
+	stats := connection.Statistics.EngineMethods["GetLayout"]
+	if stats == nil {
+		t.Fatal("no statistics collected for GetLayout")
+	}
+	avg, count := stats.RespAvg.Average()
+	if count != 2 {
+		t.Errorf("expected 2 requests, got<%d>", count)
+	}
+	if time.Duration(avg).Round(time.Millisecond) != 20*time.Millisecond {
+		t.Errorf("expected average 20ms, got<%v>", time.Duration(avg))
+	}
+	if stats.Errors.Current() != 1 || stats.Sent.Current() != 200 || stats.Received.Current() != 2000 {
+		t.Errorf("unexpected counters errors<%d> sent<%d> received<%d>", stats.Errors.Current(), stats.Sent.Current(), stats.Received.Current())
+	}
+}
+
 func TestObjectList(t *testing.T) {
 	connection := &SenseUplink{}
 
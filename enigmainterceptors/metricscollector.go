@@ -9,14 +9,26 @@ import (
 type (
 	MetricsHandler struct {
 		Log func(invocation *enigma.Invocation, metrics *enigma.InvocationMetrics, response *enigma.InvocationResponse)
+		// Trace optional, executed before invocation is sent. Returned function, if not nil, is executed with the
+		// collected metrics once response is received.
+		Trace func(invocation *enigma.Invocation) func(metrics *enigma.InvocationMetrics, response *enigma.InvocationResponse)
 	}
 )
 
 func (m *MetricsHandler) MetricsInterceptor(ctx context.Context, invocation *enigma.Invocation, proceed enigma.InterceptorContinuation) *enigma.InvocationResponse {
+	var traceEnd func(metrics *enigma.InvocationMetrics, response *enigma.InvocationResponse)
+	if m.Trace != nil {
+		traceEnd = m.Trace(invocation)
+	}
+
 	ctxWithMetrics, metricsCollector := enigma.WithMetricsCollector(ctx)
 	response := proceed(ctxWithMetrics, invocation)
 	metrics := metricsCollector.Metrics()
 	m.Log(invocation, metrics, response)
 
+	if traceEnd != nil {
+		traceEnd(metrics, response)
+	}
+
 	return response
 }
@@ -18,6 +18,14 @@ var (
 			Description: "## AskHubAdvisor action\n\nPerform a query in the Qlik Sense hub insight advisor.",
 			Examples:    "### Examples\n\n#### Pick queries from file\n\n```json\n{\n    \"action\": \"AskHubAdvisor\",\n    \"settings\": {\n        \"querysource\": \"file\",\n        \"file\": \"queries.txt\"\n    }\n}\n```\n\nThe file `queries.txt` contains one query and an optional weight per line. The line format is `[WEIGHT;]QUERY`.\n```txt\nshow sales per country\n5; what is the lowest price of shoes\n```\n\n#### Pick queries from list\n\n```json\n{\n    \"action\": \"AskHubAdvisor\",\n    \"settings\": {\n        \"querysource\": \"querylist\",\n        \"querylist\": [\"show sales per country\", \"what is the lowest price of shoes\"]\n    }\n}\n```\n\n#### Perform followup queries if possible (default: 0)\n\n```json\n{\n    \"action\": \"AskHubAdvisor\",\n    \"settings\": {\n        \"querysource\": \"querylist\",\n        \"querylist\": [\"show sales per country\", \"what is the lowest price of shoes\"],\n        \"maxfollowup\": 3\n    }\n}\n```\n\n#### Change lanuage (default: \"en\")\n\n```json\n{\n    \"action\": \"AskHubAdvisor\",\n    \"settings\": {\n        \"querysource\": \"querylist\",\n        \"querylist\": [\"show sales per country\", \"what is the lowest price of shoes\"],\n        \"lang\": \"fr\"\n    }\n}\n```\n\n#### Weights in querylist\n\n```json\n{\n    \"action\": \"AskHubAdvisor\",\n    \"settings\": {\n        \"querysource\": \"querylist\",\n        \"querylist\": [\n            {\n                \"query\": \"show sales per country\",\n                \"weight\": 5,\n            },\n            \"what is the lowest price of shoes\"\n        ]\n    }\n}\n```\n\n#### Thinktime before followup queries\n\nSee detailed examples of settings in the documentation for thinktime action.\n\n```json\n{\n    \"action\": \"AskHubAdvisor\",\n    \"settings\": {\n        \"querysource\": \"querylist\",\n        \"querylist\": [\n            \"what is the lowest price of shoes\"\n        ],\n        \"maxfollowup\": 5,\n        \"thinktime\": {\n            \"type\": \"static\",\n            \"delay\": 5\n        }\n    }\n}\n```\n\n#### Ask followups only based on app selection\n\n\n```json\n{\n    \"action\": \"AskHubAdvisor\",\n    \"settings\": {\n        \"querysource\": \"querylist\",\n        \"querylist\": [\n            \"what is the lowest price of shoes\"\n        ],\n        \"maxfollowup\": 5,\n        \"followuptypes\": [\"app\"]\n    }\n}\n```\n\n#### Save chart images to file\n\n```json\n{\n    \"action\": \"AskHubAdvisor\",\n    \"settings\": {\n        \"querysource\": \"querylist\",\n        \"querylist\": [\n            \"show price per shoe type\"\n        ],\n        \"maxfollowup\": 5,\n        \"saveimages\": true\n    }\n}\n```\n\n#### Save chart images to file with custom name\n\nThe `saveimagefile` file name template setting supports\n[Session Variables](https://github.com/qlik-trial/gopherciser-oss/blob/master/docs/settingup.md#session-variables).\nYou can apart from session variables include the following action local variables in the `saveimagefile` file name template:\n- .Local.ImageCount - _the number of images written to file_\n- .Local.ServerFileName - _the server side name of image file_\n- .Local.Query - _the query sentence_\n- .Local.AppName - _the name of app, if any app, where query is asked_\n- .Local.AppID - _the id of app, if any app, where query is asked_\n\n```json\n{\n    \"action\": \"AskHubAdvisor\",\n    \"settings\": {\n        \"querysource\": \"querylist\",\n        \"querylist\": [\n            \"show price per shoe type\"\n        ],\n        \"maxfollowup\": 5,\n        \"saveimages\": true,\n        \"saveimagefile\": \"{{.Local.Query}}--app-{{.Local.AppName}}--user-{{.UserName}}--thread-{{.Thread}}--session-{{.Session}}\"\n    }\n}\n```\n",
 		},
+		"assert": {
+			Description: "## Assert action\n\nEvaluate assertions on the latest layout and data of an object, e.g. to verify that a KPI has the expected value or that a table isn't empty. The object needs to be subscribed, e.g. by being on the current sheet. A failed assertion is reported as an action error.\n",
+			Examples:    "### Example\n\nVerify that table `QpmBJy` has rows, that the KPI value in the first cell is 1234 and that there is no calculation condition error.\n\n```json\n{\n    \"action\": \"assert\",\n    \"label\": \"verify sales table\",\n    \"settings\": {\n        \"id\": \"QpmBJy\",\n        \"assertions\": [\n            {\n                \"type\": \"rowcount\",\n                \"operator\": \"gt\",\n                \"value\": \"0\"\n            },\n            {\n                \"type\": \"value\",\n                \"row\": 0,\n                \"column\": 0,\n                \"operator\": \"eq\",\n                \"value\": \"1234\"\n            },\n            {\n                \"type\": \"nocalcconditionerror\"\n            }\n        ]\n    }\n}\n```\n",
+		},
+		"back": {
+			Description: "## Back action\n\nStep back in the selection history of the app.",
+			Examples:    "### Example\n\n```json\n{\n    \"action\": \"back\",\n    \"label\": \"Step back in selections\"\n}\n```",
+		},
 		"changesheet": {
 			Description: "## ChangeSheet action\n\nChange to a new sheet, unsubscribe to the currently subscribed objects, and subscribe to all objects on the new sheet.\n",
 			Examples:    "### Example\n\n```json\n{\n     \"label\": \"Change Sheet Dashboard\",\n     \"action\": \"ChangeSheet\",\n     \"settings\": {\n         \"id\": \"TFJhh\"\n     }\n}\n```\n",
@@ -42,6 +50,10 @@ var (
 			Description: "## Containertab action\n\nA `Containertab` action simulates switching the active object in a `container` object.\n",
 			Examples:    "### Examples\n\n```json\n{\n  \"label\": \"Switch to object qwerty in container object XYZ\",\n  \"action\": \"containertab\",\n  \"settings\": {\n    \"containerid\": \"xyz\",\n    \"mode\": \"id\",\n    \"objectid\" : \"qwerty\"\n  }\n}\n```\n\n```json\n{\n  \"label\": \"Switch to random object in container object XYZ\",\n  \"action\": \"containertab\",\n  \"settings\": {\n    \"containerid\": \"xyz\",\n    \"mode\": \"random\"\n  }\n}\n```\n\n```json\n{\n  \"label\": \"Switch to object in first tab in container object XYZ\",\n  \"action\": \"containertab\",\n  \"settings\": {\n    \"containerid\": \"xyz\",\n    \"mode\": \"index\",\n    \"index\": 0\n  }\n}\n```\n",
 		},
+		"createapp": {
+			Description: "## CreateApp action\n\nCreate a new empty app. The app is added to the artifact map and set as the current app, so subsequent actions, such as `openapp` using app selection mode `current`, can target it.\n\n**Note:** Connects a websocket without opening an app when not already connected to the engine.",
+			Examples:    "### Example\n\n```json\n{\n    \"action\": \"createapp\",\n    \"label\": \"Create app\",\n    \"settings\": {\n        \"name\": \"Load test app {{.Thread}}\"\n    }\n}\n```",
+		},
 		"createbookmark": {
 			Description: "## CreateBookmark action\n\nCreate a bookmark from the current selection and selected sheet.\n\n**Note:** Both `title` and `id` can be used to identify the bookmark in subsequent actions. \n",
 			Examples:    "### Example\n\n```json\n{\n    \"action\": \"createbookmark\",\n    \"settings\": {\n        \"title\": \"my bookmark\",\n        \"description\": \"This bookmark contains some interesting selections\"\n    }\n}\n```\n",
@@ -50,6 +62,14 @@ var (
 			Description: "## CreateSheet action\n\nCreate a new sheet in the current app.\n",
 			Examples:    "### Example\n\n```json\n{\n    \"action\": \"createsheet\",\n    \"settings\": {\n        \"title\" : \"Generated sheet\"\n    }\n}\n```\n",
 		},
+		"datepicker": {
+			Description: "## DatePicker action\n\nSelect a range of dates in the field of a date picker (`qlik-date-picker`) object. The range is selected as a search in the list object of the date picker, the same way the object does it.",
+			Examples:    "### Examples\n\nSelect a random range of dates.\n\n```json\n{\n    \"action\": \"datepicker\",\n    \"label\": \"Select random dates\",\n    \"settings\": {\n        \"id\": \"xKbPjy\",\n        \"type\": \"random\"\n    }\n}\n```\n\nSelect a defined range of dates.\n\n```json\n{\n    \"action\": \"datepicker\",\n    \"label\": \"Select January\",\n    \"settings\": {\n        \"id\": \"xKbPjy\",\n        \"type\": \"range\",\n        \"start\": \"2024-01-01\",\n        \"end\": \"2024-01-31\"\n    }\n}\n```",
+		},
+		"deleteapp": {
+			Description: "## DeleteApp action\n\nDelete an app. The app is removed from the artifact map, and is no longer the current app.\n\n**Note:** Connects a websocket without opening an app when not already connected to the engine.",
+			Examples:    "### Example\n\nDelete the app created earlier in the scenario.\n\n```json\n{\n    \"action\": \"deleteapp\",\n    \"label\": \"Delete app\",\n    \"settings\": {\n        \"appmode\": \"current\"\n    }\n}\n```",
+		},
 		"deletebookmark": {
 			Description: "## DeleteBookmark action\n\nDelete one or more bookmarks in the current app.\n\n**Note:** Specify *either* `title` *or* `id`, not both.\n",
 			Examples:    "### Example\n\n```json\n{\n    \"action\": \"deletebookmark\",\n    \"settings\": {\n        \"mode\": \"single\",\n        \"title\": \"My bookmark\"\n    }\n}\n```\n",
@@ -74,10 +94,30 @@ var (
 			Description: "## DoSave action\n\n`DoSave` issues a command to engine to save the currently open app. If the simulated user does not have permission to save the app it will result in an error.",
 			Examples:    "### Example\n\n```json\n{\n    \"label\": \"Save MyApp\",\n    \"action\" : \"dosave\"\n}\n```\n",
 		},
+		"drilldown": {
+			Description: "## Drilldown action\n\nDrill down or up in a drill-down dimension of a chart. Drilling down selects a single value in the current field of the drill-down dimension, chosen randomly among the enabled values on the current data page or defined by row. After drilling, the data of the changed objects is fetched. The object needs to be subscribed, e.g. by being on the current sheet.",
+			Examples:    "### Examples\n\nDrill into a random value of the first dimension of bar chart `ZxDKp`.\n\n```json\n{\n    \"action\": \"drilldown\",\n    \"label\": \"drill into random country\",\n    \"settings\": {\n        \"id\": \"ZxDKp\",\n        \"operation\": \"down\",\n        \"dim\": 0,\n        \"target\": \"random\"\n    }\n}\n```\n\nDrill up two levels in the first dimension of bar chart `ZxDKp`.\n\n```json\n{\n    \"action\": \"drilldown\",\n    \"label\": \"drill up to region\",\n    \"settings\": {\n        \"id\": \"ZxDKp\",\n        \"operation\": \"up\",\n        \"dim\": 0,\n        \"steps\": 2\n    }\n}\n```",
+		},
+		"duplicateapp": {
+			Description: "## DuplicateApp action\n\nDuplicate an app using the Qlik Sense Repository Service (QRS). The copy is added to the artifact map and set as the current app.",
+			Examples:    "### Example\n\n```json\n{\n    \"action\": \"duplicateapp\",\n    \"label\": \"Duplicate app\",\n    \"settings\": {\n        \"appmode\": \"name\",\n        \"app\": \"Sales\",\n        \"name\": \"Sales copy {{.Thread}}\"\n    }\n}\n```",
+		},
 		"duplicatesheet": {
 			Description: "## DuplicateSheet action\n\nDuplicate a sheet, including all objects.\n",
 			Examples:    "### Example\n\n```json\n{\n    \"action\": \"duplicatesheet\",\n    \"label\": \"Duplicate sheet1\",\n    \"settings\":{\n        \"id\" : \"mBshXB\",\n        \"save\": true,\n        \"changesheet\": true\n    }\n}\n```\n",
 		},
+		"exportapp": {
+			Description: "## ExportApp action\n\nExport an app using the Qlik Sense Repository Service (QRS) and download the resulting file.",
+			Examples:    "### Example\n\n```json\n{\n    \"action\": \"exportapp\",\n    \"label\": \"Export app without data\",\n    \"settings\": {\n        \"appmode\": \"current\",\n        \"nodata\": true\n    }\n}\n```",
+		},
+		"exportdata": {
+			Description: "## ExportData action\n\nExport data of an object, or of all objects with data on a sheet, and download the resulting file. Generation time and size of each exported file are logged as an info row labelled *ExportData* in the format `{Object ID};{Generation time (ms)};{File size (bytes)}`.",
+			Examples:    "### Examples\n\nExport data of an object to an Excel file.\n\n```json\n{\n    \"action\": \"exportdata\",\n    \"label\": \"Export table\",\n    \"settings\": {\n        \"id\": \"bKbmgT\",\n        \"filetype\": \"ooxml\"\n    }\n}\n```\n\nExport all values of all objects on a sheet to CSV files.\n\n```json\n{\n    \"action\": \"exportdata\",\n    \"label\": \"Export sheet\",\n    \"settings\": {\n        \"id\": \"QWERTY\",\n        \"filetype\": \"csv_c\",\n        \"exportstate\": \"all\"\n    }\n}\n```",
+		},
+		"forward": {
+			Description: "## Forward action\n\nStep forward in the selection history of the app.",
+			Examples:    "### Example\n\n```json\n{\n    \"action\": \"forward\",\n    \"label\": \"Step forward in selections\"\n}\n```",
+		},
 		"generateodag": {
 			Description: "## GenerateOdag action\n\nGenerate an on-demand app from an existing On-Demand App Generation (ODAG) link.\n",
 			Examples:    "### Example\n\n```json\n{\n    \"action\": \"GenerateOdag\",\n    \"settings\": {\n        \"linkname\": \"Drill to Template App\"\n    }\n}\n```\n",
@@ -86,6 +126,10 @@ var (
 			Description: "## GetScript action\n\nGet the load script for the app.\n\n",
 			Examples:    "### Example\n\nGet the load script for the app\n\n```json\n{\n    \"action\": \"getscript\"\n}\n```\n\nGet the load script for the app and save to log file\n\n```json\n{\n    \"action\": \"getscript\",\n    \"settings\": {\n        \"savelog\" : true\n    }\n}\n```\n",
 		},
+		"importapp": {
+			Description: "## ImportApp action\n\nUpload an app file (`.qvf`) from disk using the Qlik Sense Repository Service (QRS). The imported app is added to the artifact map and set as the current app.",
+			Examples:    "### Example\n\n```json\n{\n    \"action\": \"importapp\",\n    \"label\": \"Import app\",\n    \"settings\": {\n        \"filename\": \"/home/user/apps/sales.qvf\",\n        \"name\": \"Sales {{.Thread}}\",\n        \"keepdata\": true\n    }\n}\n```",
+		},
 		"iterated": {
 			Description: "## Iterated action\n\nLoop one or more actions.\n\n**Note:** This action does not require an app context (that is, it does not have to be prepended with an `openapp` action).\n",
 			Examples:    "### Example\n\n```json\n//Visit all sheets twice\n{\n     \"action\": \"iterated\",\n     \"label\": \"\",\n     \"settings\": {\n         \"iterations\" : 2,\n         \"actions\" : [\n            {\n                 \"action\": \"sheetchanger\"\n            },\n            {\n                \"action\": \"thinktime\",\n                \"settings\": {\n                    \"type\": \"static\",\n                    \"delay\": 5\n                }\n            }\n         ]\n     }\n}\n```\n",
@@ -94,6 +138,14 @@ var (
 			Description: "## ListBoxSelect action\n\nPerform list object specific selectiontypes in listbox.\n\n",
 			Examples:    "### Examples\n\n```json\n{\n     \"label\": \"ListBoxSelect\",\n     \"action\": \"ListBoxSelect\",\n     \"settings\": {\n         \"id\": \"951e2eee-ad49-4f6a-bdfe-e9e3dddeb2cd\",\n         \"type\": \"all\",\n         \"wrap\": true,\n         \"accept\": true\n     }\n}\n```\n",
 		},
+		"lockall": {
+			Description: "## LockAll action\n\nLock all selections in an app.",
+			Examples:    "### Example\n\n```json\n{\n    \"action\": \"lockall\",\n    \"label\": \"Lock all selections\"\n}\n```",
+		},
+		"lockfield": {
+			Description: "## LockField action\n\nLock selections in a field.",
+			Examples:    "### Example\n\n```json\n{\n    \"action\": \"lockfield\",\n    \"label\": \"Lock selections in Alpha\",\n    \"settings\" : {\n        \"name\": \"Alpha\"\n    }\n}\n```",
+		},
 		"objectsearch": {
 			Description: "## ObjectSearch action\n\nPerform a search select in a listbox, field or master dimension.\n\n",
 			Examples:    "### Examples\n\nSearch a listbox object, all users searches for same thing and gets an error if no result found\n\n```json\n{\n    \"label\": \"Search and select Sweden in listbox\",\n    \"action\": \"objectsearch\",\n    \"settings\": {\n        \"id\": \"maesVjgte\",\n        \"searchterms\": [\"Sweden\"],\n        \"type\": \"listbox\",\n        \"erroronempty\": true\n    }\n}\n```\n\nSearch a field. Users use one random search term from the `searchterms` list.\n\n```json\n{\n    \"label\": \"Search field\",\n    \"action\": \"objectsearch\",\n    \"disabled\": false,\n    \"settings\": {\n        \"id\": \"Countries\",\n        \"searchterms\": [\n            \"Sweden\",\n            \"Germany\",\n            \"Liechtenstein\"\n        ],\n        \"type\": \"field\"\n    }\n}\n```\n\nSearch a master object dimension using search terms from a file.\n\n```json\n{\n    \"label\": \"Search dimension\",\n    \"action\": \"objectsearch\",\n    \"disabled\": false,\n    \"settings\": {\n        \"id\": \"Dim1M\",\n        \"type\": \"dimension\",\n        \"erroronempty\": true,\n        \"source\": \"fromfile\",\n        \"searchtermsfile\": \"./resources/objectsearchterms.txt\"\n    }\n}\n```\n",
@@ -106,6 +158,14 @@ var (
 			Description: "## OpenHub action\n\nOpen the hub in a QSEoW environment. This also makes the apps included in the response for the users `myspace` available for use by subsequent actions. The action `changestream` can be used to only select from apps in a specific stream.\n",
 			Examples:    "### Example\n\n```json\n{\n     \"action\": \"OpenHub\",\n     \"label\": \"Open the hub\"\n}\n```\n",
 		},
+		"opensessionapp": {
+			Description: "## OpenSessionApp action\n\nCreate a session app, set its script and reload it. A session app only exists in the engine for the duration of the session and is never persisted, which makes it possible to test engine performance, or to run reproducible scenarios with inline data, without creating apps in the environment. Subsequent actions, such as `changesheet` and `select`, run against the session app.\n\nThe session app is either empty or a copy of a source app. A session app created from a source app keeps the script of the source app unless a script is defined.\n\n**Note:** The app is not saved after reload, and is not added to the artifact map.",
+			Examples:    "### Examples\n\nCreate an empty session app with inline data.\n\n```json\n{\n    \"action\": \"opensessionapp\",\n    \"label\": \"Session app\",\n    \"settings\": {\n        \"script\": \"Characters:\\nLoad Chr(RecNo()+Ord('A')-1) as Alpha, RecNo() as Num autogenerate 26;\",\n        \"mode\": \"abend\"\n    }\n}\n```\n\nCreate a session app from an existing app and reload it with the script of the app.\n\n```json\n{\n    \"action\": \"opensessionapp\",\n    \"label\": \"Session app from app\",\n    \"settings\": {\n        \"sourceapp\": {\n            \"appmode\": \"name\",\n            \"app\": \"Sales\"\n        }\n    }\n}\n```",
+		},
+		"pivot": {
+			Description: "## Pivot action\n\nExpand or collapse a node of a pivot table, e.g. `pivot-table` or `sn-pivot-table`, using `ExpandLeft`, `ExpandTop`, `CollapseLeft` or `CollapseTop`. The node is either chosen randomly among the nodes on the current data pages or defined by index. After the operation the first data page of the resulting pivot table is fetched. The object needs to be subscribed, e.g. by being on the current sheet.",
+			Examples:    "### Examples\n\nExpand a random node in the left dimensions of pivot table `QpmBJy`.\n\n```json\n{\n    \"action\": \"pivot\",\n    \"label\": \"expand random region\",\n    \"settings\": {\n        \"id\": \"QpmBJy\",\n        \"operation\": \"expandleft\",\n        \"target\": \"random\"\n    }\n}\n```\n\nCollapse the first node of the top dimensions of pivot table `QpmBJy`.\n\n```json\n{\n    \"action\": \"pivot\",\n    \"label\": \"collapse first year\",\n    \"settings\": {\n        \"id\": \"QpmBJy\",\n        \"operation\": \"collapsetop\",\n        \"target\": \"index\",\n        \"row\": 0,\n        \"column\": 0\n    }\n}\n```",
+		},
 		"productversion": {
 			Description: "## ProductVersion action\n\nRequest the product version from the server and, optionally, save it to the log. This is a lightweight request that can be used as a keep-alive message in a loop.\n",
 			Examples:    "### Example\n\n```json\n//Keep-alive loop\n{\n    \"action\": \"iterated\",\n    \"settings\" : {\n        \"iterations\" : 10,\n        \"actions\" : [\n            {\n                \"action\" : \"productversion\"\n            },\n            {\n                \"action\": \"thinktime\",\n                \"settings\": {\n                    \"type\": \"static\",\n                    \"delay\": 30\n                }\n            }\n        ]\n    }\n}\n```\n",
@@ -126,9 +186,17 @@ var (
 			Description: "## Reload action\n\nReload the current app by simulating selecting **Load data** in the Data load editor. To select an app, preceed this action with an `openapp` action.\n",
 			Examples:    "### Example\n\n```json\n{\n    \"action\": \"reload\",\n    \"settings\": {\n        \"mode\" : \"default\",\n        \"partial\": false\n    }\n}\n```\n",
 		},
+		"restrequest": {
+			Description: "## RestRequest action\n\nSend a generic REST request using the HTTP client of the session, e.g. towards APIs without a dedicated action. The URL, headers and body support the use of session variables. Values of the JSON response can be extracted into script variables, to be used by subsequent actions, and validated.",
+			Examples:    "### Example\n\nCreate a report and save its ID in the script variable `reportid`.\n\n```json\n{\n    \"action\": \"restrequest\",\n    \"label\": \"Create report\",\n    \"settings\": {\n        \"method\": \"post\",\n        \"url\": \"/api/v1/reports\",\n        \"body\": \"{\\\"type\\\": \\\"sense-sheet-1.0\\\", \\\"senseSheetTemplate\\\": {\\\"appId\\\": \\\"{{.Artifacts.GetIDByTypeAndName \\\"app\\\" \\\"my app\\\"}}\\\", \\\"sheet\\\": {\\\"id\\\": \\\"abc123\\\"}}, \\\"output\\\": {\\\"type\\\": \\\"pdf\\\", \\\"outputId\\\": \\\"pdf_out\\\"}}\",\n        \"expectedstatus\": [202],\n        \"extractors\": [\n            {\n                \"name\": \"reportid\",\n                \"path\": \"/id\"\n            }\n        ]\n    }\n},\n{\n    \"action\": \"restrequest\",\n    \"label\": \"Get report status\",\n    \"settings\": {\n        \"method\": \"get\",\n        \"url\": \"/api/v1/reports/{{.ScriptVars.reportid}}/status\"\n    }\n}\n```",
+		},
+		"scroll": {
+			Description: "## Scroll action\n\nScroll through the data of a `table`, `sn-table`, `pivot-table` or `sn-pivot-table` object the way a user browsing a large table would. Successive data pages are fetched with `GetHyperCubeData`, or `GetHyperCubePivotData` for pivot tables, with a think time in between pages. The object needs to be subscribed, e.g. by being on the current sheet.",
+			Examples:    "### Example\n\nScroll down 5 pages of 50 rows each in table `QpmBJy`, with a think time of 2 seconds in between pages.\n\n```json\n{\n    \"action\": \"scroll\",\n    \"label\": \"browse sales table\",\n    \"settings\": {\n        \"id\": \"QpmBJy\",\n        \"direction\": \"vertical\",\n        \"pagesize\": 50,\n        \"pages\": 5,\n        \"thinktime\": {\n            \"type\": \"static\",\n            \"delay\": 2\n        }\n    }\n}\n```",
+		},
 		"select": {
 			Description: "## Select action\n\nSelect random values in an object.\n ",
-			Examples:    "### Example\n\nRandomly select among all the values in object `RZmvzbF`.\n\n```json\n{\n     \"label\": \"ListBox Year\",\n     \"action\": \"Select\",\n     \"settings\": {\n         \"id\": \"RZmvzbF\",\n         \"type\": \"RandomFromAll\",\n         \"accept\": true,\n         \"wrap\": false,\n         \"min\": 1,\n         \"max\": 3,\n         \"dim\": 0\n     }\n}\n```\n\nRandomly select among all the enabled values (a.k.a \"white\" values) in object `RZmvzbF`.\n\n```json\n{\n     \"label\": \"ListBox Year\",\n     \"action\": \"Select\",\n     \"settings\": {\n         \"id\": \"RZmvzbF\",\n         \"type\": \"RandomFromEnabled\",\n         \"accept\": true,\n         \"wrap\": false,\n         \"min\": 1,\n         \"max\": 3,\n         \"dim\": 0\n     }\n}\n```\n\n#### Statically selecting specific values\n\nThis example selects specific element values in object `RZmvzbF`. These are the values which can be seen in a selection when e.g. inspecting traffic, it is not the data values presented to the user. E.g. when loading a table in the following order by a Sense loadscript:\n\n```\nBeta\nAlpha\nGamma\n```\n\nwhich might be presented to the user sorted as\n\n```\nAlpha\nBeta\nGamma\n```\n\nThe element values will be Beta=0, Alpha=1 and Gamma=2.\n\nTo statically select \"Gamma\" in this case:\n\n```json\n{\n     \"label\": \"Select Gammma\",\n     \"action\": \"Select\",\n     \"settings\": {\n         \"id\": \"RZmvzbF\",\n         \"type\": \"values\",\n         \"accept\": true,\n         \"wrap\": false,\n         \"values\" : [2],\n         \"dim\": 0\n     }\n}\n```\n",
+			Examples:    "### Example\n\nRandomly select among all the values in object `RZmvzbF`.\n\n```json\n{\n     \"label\": \"ListBox Year\",\n     \"action\": \"Select\",\n     \"settings\": {\n         \"id\": \"RZmvzbF\",\n         \"type\": \"RandomFromAll\",\n         \"accept\": true,\n         \"wrap\": false,\n         \"min\": 1,\n         \"max\": 3,\n         \"dim\": 0\n     }\n}\n```\n\nRandomly select among all the enabled values (a.k.a \"white\" values) in object `RZmvzbF`.\n\n```json\n{\n     \"label\": \"ListBox Year\",\n     \"action\": \"Select\",\n     \"settings\": {\n         \"id\": \"RZmvzbF\",\n         \"type\": \"RandomFromEnabled\",\n         \"accept\": true,\n         \"wrap\": false,\n         \"min\": 1,\n         \"max\": 3,\n         \"dim\": 0\n     }\n}\n```\n\n#### Statically selecting specific values\n\nThis example selects specific element values in object `RZmvzbF`. These are the values which can be seen in a selection when e.g. inspecting traffic, it is not the data values presented to the user. E.g. when loading a table in the following order by a Sense loadscript:\n\n```\nBeta\nAlpha\nGamma\n```\n\nwhich might be presented to the user sorted as\n\n```\nAlpha\nBeta\nGamma\n```\n\nThe element values will be Beta=0, Alpha=1 and Gamma=2.\n\nTo statically select \"Gamma\" in this case:\n\n```json\n{\n     \"label\": \"Select Gammma\",\n     \"action\": \"Select\",\n     \"settings\": {\n         \"id\": \"RZmvzbF\",\n         \"type\": \"values\",\n         \"accept\": true,\n         \"wrap\": false,\n         \"values\" : [2],\n         \"dim\": 0\n     }\n}\n```\n\n#### Select range\n\nSelect the bars of bar chart `ZxDKp` where the first measure is between 1000 and 5000.\n\n```json\n{\n     \"label\": \"Select sales range\",\n     \"action\": \"Select\",\n     \"settings\": {\n         \"id\": \"ZxDKp\",\n         \"type\": \"range\",\n         \"accept\": true,\n         \"wrap\": true,\n         \"range\": {\n             \"type\": \"measure\",\n             \"measure\": 0,\n             \"min\": 1000,\n             \"max\": 5000,\n             \"mininclusive\": true,\n             \"maxinclusive\": true\n         }\n     }\n}\n```\n\n#### Select by search\n\nSearch listbox `RZmvzbF` for values starting with \"Swe\" and accept the search result.\n\n```json\n{\n     \"label\": \"Search and select countries\",\n     \"action\": \"Select\",\n     \"settings\": {\n         \"id\": \"RZmvzbF\",\n         \"type\": \"search\",\n         \"accept\": true,\n         \"wrap\": true,\n         \"search\": \"Swe*\"\n     }\n}\n```",
 		},
 		"setscript": {
 			Description: "## SetScript action\n\nSet the load script for the current app. To load the data from the script, use the `reload` action after the `setscript` action.\n",
@@ -162,6 +230,14 @@ var (
 			Description: "## ThinkTime action\n\nSimulate user think time.\n\n**Note:** This action does not require an app context (that is, it does not have to be prepended with an `openapp` action).\n",
 			Examples:    "### Examples\n\n#### ThinkTime uniform\n\nThis simulates a think time of 10 to 15 seconds.\n\n```json\n{\n     \"label\": \"TimerDelay\",\n     \"action\": \"thinktime\",\n     \"settings\": {\n         \"type\": \"uniform\",\n         \"mean\": 12.5,\n         \"dev\": 2.5\n     } \n} \n```\n\n#### ThinkTime constant\n\nThis simulates a think time of 5 seconds.\n\n```json\n{\n     \"label\": \"TimerDelay\",\n     \"action\": \"thinktime\",\n     \"settings\": {\n         \"type\": \"static\",\n         \"delay\": 5\n     }\n}\n```\n",
 		},
+		"unlockall": {
+			Description: "## UnlockAll action\n\nUnlock all selections in an app.",
+			Examples:    "### Example\n\n```json\n{\n    \"action\": \"unlockall\",\n    \"label\": \"Unlock all selections\"\n}\n```",
+		},
+		"unlockfield": {
+			Description: "## UnlockField action\n\nUnlock selections in a field.",
+			Examples:    "### Example\n\n```json\n{\n    \"action\": \"unlockfield\",\n    \"label\": \"Unlock selections in Alpha\",\n    \"settings\" : {\n        \"name\": \"Alpha\"\n    }\n}\n```",
+		},
 		"unpublishbookmark": {
 			Description: "## UnpublishBookmark action\n\nUnpublish a bookmark.\n\n**Note:** Specify *either* `title` *or* `id`, not both.\n",
 			Examples:    "### Example\n\nUnpublish the bookmark with `id` \"bookmark1\" that was created earlier on in the script.\n\n```json\n{\n    \"label\" : \"Unpublish bookmark 1\",\n    \"action\": \"unpublishbookmark\",\n    \"disabled\" : false,\n    \"settings\" : {\n        \"id\" : \"bookmark1\"\n    }\n}\n```\n\nUnpublish the bookmark with the `title` \"bookmark of testuser\", where \"testuser\" is the username of the simulated user.\n\n```json\n{\n    \"label\" : \"Unpublish bookmark 2\",\n    \"action\": \"unpublishbookmark\",\n    \"disabled\" : false,\n    \"settings\" : {\n        \"title\" : \"bookmark of {{.UserName}}\"\n    }\n}\n```\n",
@@ -174,6 +250,10 @@ var (
 			Description: "## Unsubscribeobjects action\n\nUnsubscribe to any currently subscribed object.\n",
 			Examples:    "### Example\n\nUnsubscribe from a single object (or a list of objects).\n\n```json\n{\n    \"action\" : \"unsubscribeobjects\",\n    \"label\" : \"unsubscribe from object maVjt and its children\",\n    \"disabled\": false,\n    \"settings\" : {\n        \"ids\" : [\"maVjt\"]\n    }\n}\n```\n\nUnsubscribe from all currently subscribed objects.\n\n```json\n{\n    \"action\" : \"unsubscribeobjects\",\n    \"label\" : \"unsubscribe from all objects\",\n    \"disabled\": false,\n    \"settings\" : {\n        \"clear\": true\n    }\n}\n```",
 		},
+		"variableinput": {
+			Description: "## VariableInput action\n\nSet the variable of a variable input (`qlik-variable-input`) object the way the object would. The variable name, and the values to choose from, are read from the object layout. Buttons and drop down set one of the defined values, a slider sets a value within its range and an input box sets the given value.",
+			Examples:    "### Examples\n\nSet a random value of the variable input object.\n\n```json\n{\n    \"action\": \"variableinput\",\n    \"label\": \"Set random variable value\",\n    \"settings\": {\n        \"id\": \"mBshXB\"\n    }\n}\n```\n\nSet a specific value.\n\n```json\n{\n    \"action\": \"variableinput\",\n    \"label\": \"Set variable value\",\n    \"settings\": {\n        \"id\": \"mBshXB\",\n        \"value\": \"Sweden\"\n    }\n}\n```",
+		},
 	}
 
 	Schedulers = map[string]common.DocEntry{
@@ -185,6 +265,7 @@ var (
 
 	Params = map[string][]string{
 		"applybookmark.selectionsonly":                    {"Apply selections only."},
+		"applybookmark.state":                             {"Alternate state to track current selections of. Applying a bookmark always applies the selections of all states stored in the bookmark. Defaults to the default state (`$`)."},
 		"appselection.app":                                {"App name or app GUID (supports the use of [session variables](#session_variables)). Used with `appmode` set to `guid` or `name`."},
 		"appselection.appmode":                            {"App selection mode", "`current`: (default) Use the current app, selected by an app selection in a previous action", "`guid`: Use the app GUID specified by the `app` parameter.", "`name`: Use the app name specified by the `app` parameter.", "`random`: Select a random app from the artifact map, which is filled by e.g. `openhub`", "`randomnamefromlist`: Select a random app from a list of app names. The `list` parameter should contain a list of app names.", "`randomguidfromlist`: Select a random app from a list of app GUIDs. The `list` parameter should contain a list of app GUIDs.", "`randomnamefromfile`: Select a random app from a file with app names. The `filename` parameter should contain the path to a file in which each line represents an app name.", "`randomguidfromfile`: Select a random app from a file with app GUIDs. The `filename` parameter should contain the path to a file in which each line represents an app GUID.", "`round`: Select an app from the artifact map according to the round-robin principle.", "`roundnamefromlist`: Select an app from a list of app names according to the round-robin principle. The `list` parameter should contain a list of app names.", "`roundguidfromlist`: Select an app from a list of app GUIDs according to the round-robin principle. The `list` parameter should contain a list of app GUIDs.", "`roundnamefromfile`: Select an app from a file with app names according to the round-robin principle. The `filename` parameter should contain the path to a file in which each line represents an app name.", "`roundguidfromfile`: Select an app from a file with app GUIDs according to the round-robin principle. The `filename` parameter should contain the path to a file in which each line represents an app GUID."},
 		"appselection.filename":                           {"Path to a file in which each line represents an app. Used with `appmode` set to `randomnamefromfile`, `randomguidfromfile`, `roundnamefromfile` or `roundguidfromfile`."},
@@ -201,12 +282,21 @@ var (
 		"askhubadvisor.saveimagefile":                     {"File name of saved images. Defaults to server side file name. Supports [Session Variables](https://github.com/qlik-trial/gopherciser-oss/blob/master/docs/settingup.md#session-variables)."},
 		"askhubadvisor.saveimages":                        {"Save images of charts to file."},
 		"askhubadvisor.thinktime":                         {"Settings for the `thinktime` action, which is automatically inserted before each followup. Defaults to a uniform distribution with mean=8 and deviation=4."},
+		"assert.assertions":                               {"List of assertions evaluated on the object. Each failed assertion is reported as an error."},
+		"assert.assertions.column":                        {"Column of the cell for `value` assertions. Defaults to `0`."},
+		"assert.assertions.operator":                      {"Operator used to compare with `value`. Defaults to `eq`.", "`eq`: Equal to.", "`ne`: Not equal to.", "`gt`: Greater than, requires a numeric `value`.", "`gte`: Greater than or equal to, requires a numeric `value`.", "`lt`: Less than, requires a numeric `value`.", "`lte`: Less than or equal to, requires a numeric `value`.", "`contains`: Cell text contains `value`."},
+		"assert.assertions.row":                           {"Row of the cell for `value` assertions. Defaults to `0`."},
+		"assert.assertions.type":                          {"Assertion type", "`rowcount`: Compare the amount of rows of the hypercube or listobject with `value`.", "`value`: Compare the cell at `row` and `column` of the data pages with `value`. Cells with a numeric value are compared as numbers when `value` is a number, otherwise the text of the cell is compared.", "`nocalcconditionerror`: The object doesn't have an unfulfilled calculation condition.", "`noerror`: Neither the object, its dimensions or measures have any errors."},
+		"assert.assertions.value":                         {"Expected value, used by `rowcount` and `value` assertions."},
+		"assert.id":                                       {"ID of the object to evaluate assertions on."},
 		"bookmark.id":                                     {"ID of the bookmark."},
 		"bookmark.title":                                  {"Name of the bookmark (supports the use of [variables](#session_variables))."},
 		"changesheet.id":                                  {"GUID of the sheet to change to."},
 		"changestream.mode":                               {"Decides what kind of value the `stream` field contains. Defaults to `name`.", "`name`: `stream` is the name of the stream.", "`id`: `stream` is the ID if the stream."},
 		"changestream.stream":                             {"Name or id of stream to change to depending on `mode`."},
+		"clearall.state":                                  {"Alternate state to clear selections in, only objects bound to the state are affected. Defaults to the default state (`$`)."},
 		"clearfield.name":                                 {"Name of field to clear."},
+		"clearfield.state":                                {"Alternate state to clear the field selections in, only objects bound to the state are affected. Defaults to the default state (`$`)."},
 		"clickactionbutton.id":                            {"ID of the action-button to click."},
 		"config.connectionSettings.allowuntrusted":        {"Allow untrusted (for example, self-signed) certificates (`true` / `false`). Defaults to `false`, if omitted."},
 		"config.connectionSettings.appext":                {"Replace `app` in the connect URL for the `openapp` action. Defaults to `app`, if omitted."},
@@ -253,27 +343,47 @@ var (
 		"config.settings":                                 {"This section of the JSON file contains timeout and logging settings for the load scenario"},
 		"config.settings.logs":                            {"Log settings"},
 		"config.settings.logs.debug":                      {"Log debug information (`true` / `false`). Defaults to `false`, if omitted."},
-		"config.settings.logs.filename":                   {"Name of the log file (supports the use of [variables](#session_variables))."},
-		"config.settings.logs.format":                     {"Log format. Defaults to `tsvfile`, if omitted.", "`tsvfile`: Log to file in TSV format and output status to console.", "`tsvconsole`: Log to console in TSV format without any status output.", "`jsonfile`: Log to file in JSON format and output status to console.", "`jsonconsole`: Log to console in JSON format without any status output.", "`console`: Log to console in color format without any status output.", "`combined`: Log to file in TSV format and to console in JSON format.", "`no`: Default logs and status output turned off.", "`onlystatus`: Default logs turned off, but status output turned on."},
+		"config.settings.logs.filename":                   {"Name of the log file (supports the use of [variables](#session_variables)). When log rotation is enabled, the template function `segment` returns the index of the current log segment, starting at `0` (for example, `logs/{{.ConfigFile}}-{{segment}}.tsv`). If the filename does not use `segment`, rotated segments are named with a `-001`, `-002`, etc. suffix."},
+		"config.settings.logs.format":                     {"Log format. Defaults to `tsvfile`, if omitted.", "`tsvfile`: Log to file in TSV format and output status to console.", "`tsvconsole`: Log to console in TSV format without any status output.", "`jsonfile`: Log to file in JSON format and output status to console.", "`jsonconsole`: Log to console in JSON format without any status output.", "`console`: Log to console in color format without any status output.", "`combined`: Log to file in TSV format and to console in JSON format.", "`no`: Default logs and status output turned off.", "`onlystatus`: Default logs turned off, but status output turned on.", "`arrowfile`: Log to file in Apache Arrow IPC stream format and output status to console. Rows are written in record batches of 10000 rows with a fixed schema, which loads considerably faster than TSV into columnar analytics tools such as pandas, Polars or DuckDB."},
+		"config.settings.logs.interval":                   {"Write time-series statistics aggregated per interval to file during the execution. Each interval produces one row with totals followed by one row per unique combination of action, label and app GUID with activity during the interval. Actions are counted when completed, and the totals row equals the sum of the action rows. Response times are in nanoseconds."},
+		"config.settings.logs.interval.filename":          {"Name of the interval statistics file. Defaults to `intervals.csv`. Supports the same variables as the log filename."},
+		"config.settings.logs.interval.format":            {"Format of the interval statistics file.", "`csv`: Comma separated values with a header row (default).", "`jsonl`: One JSON object per row."},
+		"config.settings.logs.interval.period":            {"Length of each interval (for example, `10s` or `1m`). Interval statistics are turned off if not set."},
 		"config.settings.logs.metrics":                    {"Log traffic metrics (`true` / `false`). Defaults to `false`, if omitted. **Note:** This should only be used for debugging purposes as traffic logging is resource-demanding."},
-		"config.settings.logs.regression":                 {"Log regression data (`true` / `false`). Defaults to `false`, if omitted. **Note:** Do not log regression data when testing performance. **Note** With regression logging enabled, the the scheduler is implicitly set to execute the scenario as one user for one iteration."},
-		"config.settings.logs.summary":                    {"Type of summary to display after the test run. Defaults to simple for minimal performance impact.", "`0` or `undefined`: Simple, single-row summary", "`1` or `none`: No summary", "`2` or `simple`: Simple, single-row summary", "`3` or `extended`: Extended summary that includes statistics on each unique combination of action, label and app GUID", "`4` or `full`: Same as extended, but with statistics on each unique combination of method and endpoint added"},
-		"config.settings.logs.summaryfile":                {"Name of summary file, only used when using summary type `file`. Defaults to `summary.json`"},
+		"config.settings.logs.regression":                 {"Log regression data (`true` / `false`). Defaults to `false`, if omitted. **Note:** Do not log regression data when testing performance. **Note** With regression logging enabled, the the scheduler is implicitly set to execute the scenario as one user for one iteration. Use `gopherciser regression diff <baseline> <candidate>` to list objects returning different data in two regression logs, e.g. before and after an upgrade or app reload."},
+		"config.settings.logs.rotation":                   {"Split the log file into segments when a segment exceeds a size or age. Applies to the `tsvfile`, `jsonfile`, `arrowfile` and `combined` log formats. TSV segments start with a header row and each Arrow segment is a complete stream, making segments usable as partitions of the log. Rotation is turned off if neither `maxsize` nor `interval` is set."},
+		"config.settings.logs.rotation.compression":       {"Compression of rotated segments. The segment currently written to is never compressed.", "`none`: Rotated segments are not compressed (default).", "`gzip`: Rotated segments are compressed with gzip and get the extension `.gz`.", "`zstd`: Rotated segments are compressed with zstd and get the extension `.zst`."},
+		"config.settings.logs.rotation.interval":          {"Start a new segment when the current segment is older than this duration (for example, `1h`)."},
+		"config.settings.logs.rotation.maxsize":           {"Start a new segment when the current segment would exceed this size in megabytes."},
+		"config.settings.logs.rotation.retention":         {"Number of rotated segments to keep, older segments are removed. Defaults to `0`, which keeps all segments."},
+		"config.settings.logs.summary":                    {"Type of summary to display after the test run. Defaults to simple for minimal performance impact.", "`0` or `undefined`: Simple, single-row summary", "`1` or `none`: No summary", "`2` or `simple`: Simple, single-row summary", "`3` or `extended`: Extended summary that includes statistics on each unique combination of action, label and app GUID, including response time percentiles (p50, p90, p95, p99 and max), as well as logged errors grouped by fingerprint with count and time of first and last occurrence", "`4` or `full`: Same as extended, but with statistics on each unique combination of method and endpoint as well as on each engine method (e.g. `GetLayout`, `GetHyperCubeData`) added", "`5` or `file`: Same statistics as extended and full summary written as JSON to a summary file, including mergeable response time histograms"},
+		"config.settings.logs.summaryfile":                {"Name of summary file, only used when using summary type `file`. Defaults to `summary.json`. The file contains the summary totals as well as statistics per action, per REST request, per engine method and per error fingerprint, response times are in nanoseconds. **Note:** The format of the summary file changed from a list of totals to an object with the field `version` set to `2`. Summary files of the earlier format only contain the totals, and are read as version `1`."},
 		"config.settings.logs.traffic":                    {"Log traffic information (`true` / `false`). Defaults to `false`, if omitted. **Note:** This should only be used for debugging purposes as traffic logging is resource-demanding."},
 		"config.settings.maxerrors":                       {"Break execution if max errors exceeded. 0 - Do not break. Defaults to 0."},
 		"config.settings.outputs":                         {"Used by some actions to save results to a file."},
 		"config.settings.outputs.dir":                     {"Directory in which to save artifacts generated by the script (except log file)."},
 		"config.settings.timeout":                         {"Timeout setting (seconds) for requests."},
+		"config.thresholds":                               {"This section of the JSON file contains pass/fail thresholds evaluated at the end of the execution."},
+		"config.thresholds.action":                        {"(optional) Only evaluate actions of this type, e.g. `openapp`. Defaults to all actions."},
+		"config.thresholds.label":                         {"(optional) Only evaluate actions with this label. Defaults to all labels."},
+		"config.thresholds.limit":                         {"Limit of threshold. A duration (for example, `500ms` or `5s`) for `responsetime`, a percentage for `errorrate` and a number for `errors`, `warnings` and `requestrate`."},
+		"config.thresholds.percentile":                    {"(`responsetime` only) Response time percentile (0-100) to evaluate. Defaults to 95."},
+		"config.thresholds.type":                          {"Type of threshold.", "`responsetime`: Percentile response time of successful actions must be lower than `limit`.", "`errorrate`: Percentage of failed actions must be lower than `limit`.", "`errors`: Amount of errors must be lower than `limit`.", "`warnings`: Amount of warnings must be lower than `limit`.", "`requestrate`: Total requests per second must be higher than `limit`."},
 		"containertab.containerid":                        {"ID of the container object."},
 		"containertab.index":                              {"Zero based index of tab to switch to, used with mode `index`."},
 		"containertab.mode":                               {"Mode for container tab switching, one of: `objectid`, `random` or `index`.", "`objectid`: Switch to tab with object defined by `objectid`.", "`random`: Switch to a random visible tab within the container.", "`index`: Switch to tab with zero based index defined but `index`."},
 		"containertab.objectid":                           {"ID of the object to set as active, used with mode `objectid`."},
+		"createapp.name":                                  {"Name of the new app. Supports the use of [session variables](https://github.com/qlik-oss/gopherciser/tree/master/docs/sessionvariables.md)."},
 		"createbookmark.description":                      {"(optional) Description of the bookmark to create."},
 		"createbookmark.nosheet":                          {"Do not include the sheet location in the bookmark."},
 		"createbookmark.savelayout":                       {"Include the layout in the bookmark."},
 		"createsheet.description":                         {"(optional) Description of the sheet to create."},
 		"createsheet.id":                                  {"(optional) ID to be used to identify the sheet in any subsequent `changesheet`, `duplicatesheet`, `publishsheet` or `unpublishsheet` action."},
 		"createsheet.title":                               {"Name of the sheet to create."},
+		"datepicker.end":                                  {"Last date of range, formatted as the dates of the field. Used with selection type `range`. Supports the use of [session variables](#session_variables)."},
+		"datepicker.id":                                   {"ID of the date picker (`qlik-date-picker`) object."},
+		"datepicker.start":                                {"First date of range, formatted as the dates of the field. Used with selection type `range`. Supports the use of [session variables](#session_variables)."},
+		"datepicker.type":                                 {"Type of date range selection", "`random`: Select a random range of dates between the first and last date of the field.", "`range`: Select the range of dates between `start` and `end`."},
 		"deletebookmark.mode":                             {"", "`single`: Delete one bookmark that matches the specified `title` or `id` in the current app.", "`matching`: Delete all bookmarks with the specified `title` in the current app.", "`all`: Delete all bookmarks in the current app."},
 		"deleteodag.linkname":                             {"Name of the ODAG link from which to delete generated apps. The name is displayed in the ODAG navigation bar at the bottom of the *selection app*."},
 		"deletesheet.id":                                  {"(optional) GUID of the sheet to delete."},
@@ -281,10 +391,22 @@ var (
 		"deletesheet.title":                               {"(optional) Name of the sheet to delete."},
 		"destinationspace.destinationspaceid":             {"Specify destination space by ID."},
 		"destinationspace.destinationspacename":           {"Specify destination space by name."},
+		"drilldown.dim":                                   {"Index of the drill-down dimension in the hypercube of the object (defaults to 0)."},
+		"drilldown.id":                                    {"ID of the chart object with a drill-down dimension."},
+		"drilldown.operation":                             {"Drill operation", "`down`: Drill down one level by selecting a value in the current field of the drill-down dimension (default).", "`up`: Drill up `steps` levels in the drill-down dimension."},
+		"drilldown.row":                                   {"Row of the current data page containing the value to drill into, when using target `index`."},
+		"drilldown.steps":                                 {"Number of levels to drill up when using operation `up`. Defaults to 1."},
+		"drilldown.target":                                {"Value to drill into", "`random`: Randomly drill into one of the enabled values on the current data page (default).", "`index`: Drill into the value on `row` of the current data page."},
+		"duplicateapp.name":                               {"(optional) Name of the copy. When not set, the name is decided by the server. Supports the use of [session variables](https://github.com/qlik-oss/gopherciser/tree/master/docs/sessionvariables.md)."},
 		"duplicatesheet.changesheet":                      {"Clear the objects currently subscribed to and then subribe to all objects on the cloned sheet (which essentially corresponds to using the `changesheet` action to go to the cloned sheet) (`true` / `false`). Defaults to `false`, if omitted."},
 		"duplicatesheet.cloneid":                          {"(optional) ID to be used to identify the sheet in any subsequent `changesheet`, `duplicatesheet`, `publishsheet` or `unpublishsheet` action."},
 		"duplicatesheet.id":                               {"(optional) ID of the sheet to clone. If no id provided the current sheet will be duplicated (e.g. from previous `changesheet` action)."},
 		"duplicatesheet.save":                             {"Execute `saveobjects` after the cloning operation to save all modified objects (`true` / `false`). Defaults to `false`, if omitted."},
+		"exportapp.nodata":                                {"Export the app without data (default: `false`)."},
+		"exportdata.exportstate":                          {"Which values to export", "`possible`: Export only possible values. (Default)", "`all`: Export all values."},
+		"exportdata.filetype":                             {"File format of export", "`ooxml`: Export data as an Excel (xlsx) file. (Default)", "`csv_c`: Export data as comma separated CSV file.", "`csv_t`: Export data as tab separated CSV file."},
+		"exportdata.id":                                   {"ID of object to export data from. When the ID is a sheet, data is exported from all objects with data on the sheet."},
+		"exportdata.serveonce":                            {"Exported file can only be downloaded once. Defaults to `false`."},
 		"generateodag.linkname":                           {"Name of the ODAG link from which to generate an app. The name is displayed in the ODAG navigation bar at the bottom of the *selection app*."},
 		"getscript.savelog":                               {"Save load script to log file under the INFO log labelled *LoadScript*"},
 		"hook.content":                                    {"(optional) Content of request."},
@@ -302,12 +424,19 @@ var (
 		"hook.method":                                     {"Method of request, defaults to none."},
 		"hook.respcodes":                                  {"Accepted response codes, defaults to 200."},
 		"hook.url":                                        {"Url to send a request towards."},
+		"importapp.filename":                              {"Path to the app file (`.qvf`) to upload."},
+		"importapp.keepdata":                              {"Keep the data of the uploaded app (default: `false`)."},
+		"importapp.name":                                  {"(optional) Name of the imported app. Defaults to the filename without extension. Supports the use of [session variables](https://github.com/qlik-oss/gopherciser/tree/master/docs/sessionvariables.md)."},
 		"iterated.actions":                                {"Actions to iterate"},
 		"iterated.iterations":                             {"Number of loops."},
 		"listboxselect.accept":                            {"Accept or abort selection after selection (only used with `wrap`) (`true` / `false`)."},
 		"listboxselect.id":                                {"ID of the listbox in which to select values."},
+		"listboxselect.state":                             {"Alternate state the listbox is expected to be bound to. Selections are made in the state of the listbox, the action fails if the listbox is bound to another state. Current selections of the state are tracked. Defaults to no verification of state."},
 		"listboxselect.type":                              {"Selection type.", "`all`: Select all values.", "`alternative`: Select alternative values.", "`excluded`: Select excluded values.", "`possible`: Select possible values."},
 		"listboxselect.wrap":                              {"Wrap selection with Begin / End selection requests (`true` / `false`)."},
+		"lockall.state":                                   {"Alternate state to lock selections in. Defaults to the default state (`$`)."},
+		"lockfield.name":                                  {"Name of field to lock."},
+		"lockfield.state":                                 {"Alternate state to lock the field selections in. Defaults to the default state (`$`)."},
 		"objectsearch.erroronempty":                       {"If set to true and the object search yields an empty result, the action will result in an error. Defaults to false."},
 		"objectsearch.id":                                 {"Identifier for the object, this would differ depending on `type`.", "`listbox`: Use the ID of listbox object", "`field`: Use the name of the field", "`dimension`: Use the title of the dimension masterobject."},
 		"objectsearch.searchterms":                        {"List of search terms to search for."},
@@ -320,6 +449,16 @@ var (
 		"openapp.timeouts.connect":                        {"(optional) Custom timeout for connecting to engine (for example, `10m`, `30s` or `1m10s`)"},
 		"openapp.timeouts.open":                           {"(optional) Custom timeout for openapp request (for example, `10m`, `30s` or `1m10s`)"},
 		"openapp.unique":                                  {"Create unqiue engine session not re-using session from previous connection with same user. Defaults to false."},
+		"opensessionapp.log":                              {"Save the reload log as a field in the output (`true` / `false`). Defaults to `false`, if omitted. **Note:** This should only be used when needed as the reload log can become very large."},
+		"opensessionapp.mode":                             {"Error handling during the reload operation", "`default`: Use the default error handling.", "`abend`: Stop reloading the script, if an error occurs.", "`ignore`: Continue reloading the script even if an error is detected in the script."},
+		"opensessionapp.script":                           {"Load script of the session app (written as a string). Required when no source app is defined, otherwise replaces the script of the source app."},
+		"opensessionapp.sourceapp":                        {"(optional) App to create the session app from, using the app selection settings `appmode`, `app`, `list` and `filename`. When omitted, an empty session app is created."},
+		"pivot.all":                                       {"Expand or collapse all nodes instead of a single node (`true` / `false`). Defaults to `false`."},
+		"pivot.column":                                    {"Column of the node to expand or collapse when using target `index`. For left operations this is the dimension index of the node, for top operations the column of the node."},
+		"pivot.id":                                        {"ID of the pivot table object."},
+		"pivot.operation":                                 {"Operation on pivot table node", "`expandleft`: Expand a node in the left dimensions.", "`expandtop`: Expand a node in the top dimensions.", "`collapseleft`: Collapse a node in the left dimensions.", "`collapsetop`: Collapse a node in the top dimensions."},
+		"pivot.row":                                       {"Row of the node to expand or collapse when using target `index`. For left operations this is the row of the node, for top operations the dimension index of the node."},
+		"pivot.target":                                    {"Node to expand or collapse", "`random`: Randomly choose one of the nodes on the current data pages which can be expanded or collapsed (default).", "`index`: Use node defined by `row` and `column`."},
 		"productversion.log":                              {"Save the product version to the log (`true` / `false`). Defaults to `false`, if omitted."},
 		"publishsheet.includePublished":                   {"Try to publish already published sheets."},
 		"publishsheet.mode":                               {"", "`allsheets`: Publish all sheets in the app.", "`sheetids`: Only publish the sheets specified by the `sheetIds` array."},
@@ -337,12 +476,35 @@ var (
 		"reload.mode":                                     {"Error handling during the reload operation", "`default`: Use the default error handling.", "`abend`: Stop reloading the script, if an error occurs.", "`ignore`: Continue reloading the script even if an error is detected in the script."},
 		"reload.nosave":                                   {"Do not send a save request for the app after the reload is done. Defaults to saving the app."},
 		"reload.partial":                                  {"Enable partial reload (`true` / `false`). This allows you to add data to an app without reloading all data. Defaults to `false`, if omitted."},
+		"restrequest.body":                                {"(optional) Content of request. Supports the use of [session variables](#session_variables)."},
+		"restrequest.contenttype":                         {"(optional) Request content-type header. Defaults to `application/json`."},
+		"restrequest.expectedstatus":                      {"(optional) Accepted response status codes. Defaults to `[200, 201]`."},
+		"restrequest.extractors":                          {"(optional) Extractors, used to extract values from the JSON response into script variables for subsequent actions, e.g. `{{ .ScriptVars.MyExtractorName }}`, or to validate that part of the response has a specific value. Extractors are defined the same way as for hooks."},
+		"restrequest.headers":                             {"(optional) Custom headers to add to the request."},
+		"restrequest.headers.name":                        {"Name of header."},
+		"restrequest.headers.value":                       {"Value of header. Supports the use of [session variables](#session_variables)."},
+		"restrequest.method":                              {"HTTP method of request.", "`get`: GET request.", "`post`: POST request.", "`put`: PUT request.", "`patch`: PATCH request.", "`delete`: DELETE request.", "`head`: HEAD request.", "`options`: OPTIONS request."},
+		"restrequest.url":                                 {"URL to send request to. A URL starting with `/` is relative to the REST URL of the connection, e.g. `/api/v1/reports`. Supports the use of [session variables](#session_variables)."},
+		"scroll.direction":                                {"Direction to scroll the object data.", "`vertical`: Scroll down through the rows of the object (default).", "`horizontal`: Scroll right through the columns of the object."},
+		"scroll.id":                                       {"ID of the `table`, `sn-table`, `pivot-table` or `sn-pivot-table` object to scroll."},
+		"scroll.pages":                                    {"Number of pages to fetch. Scrolling starts after the data currently held by the object and stops early when reaching the end of the data."},
+		"scroll.pagesize":                                 {"Number of rows (`vertical`) or columns (`horizontal`) fetched per scroll. Defaults to 40 rows or 10 columns. The page height is limited to keep a page within 10000 cells."},
+		"scroll.thinktime":                                {"Settings for the `thinktime` action, which is automatically inserted in between fetched pages. Defaults to a uniform distribution with mean 1 second and deviation 0.5 seconds."},
 		"select.accept":                                   {"Accept or abort selection after selection (only used with `wrap`) (`true` / `false`)."},
 		"select.dim":                                      {"Dimension / column in which to select."},
 		"select.id":                                       {"ID of the object in which to select values."},
 		"select.max":                                      {"Maximum number of selections to make."},
 		"select.min":                                      {"Minimum number of selections to make."},
-		"select.type":                                     {"Selection type", "`randomfromall`: Randomly select within all values of the symbol table.", "`randomfromenabled`: Randomly select within the white and light grey values on the first data page.", "`randomfromexcluded`: Randomly select within the dark grey values on the first data page.", "`randomdeselect`: Randomly deselect values on the first data page.", "`values`: Select specific element values, defined by `values` array."},
+		"select.range":                                    {"Numeric range to select when using selection type `range`."},
+		"select.range.max":                                {"Upper bound of the range."},
+		"select.range.maxinclusive":                       {"Include the upper bound in the range (`true` / `false`). Defaults to `false`."},
+		"select.range.measure":                            {"Index of the measure to select a range in when using range type `measure` (defaults to 0)."},
+		"select.range.min":                                {"Lower bound of the range."},
+		"select.range.mininclusive":                       {"Include the lower bound in the range (`true` / `false`). Defaults to `false`."},
+		"select.range.type":                               {"Type of range selection", "`measure`: Select the dimension values for which `measure` is within the range, e.g. a range of bars in a bar chart (default).", "`continuous`: Select a range on the continuous axis of dimension `dim`, e.g. in a line chart with a continuous x-axis."},
+		"select.search":                                   {"Text to search for in a listbox when using selection type `search`. Supports the same search syntax as the listbox search, e.g. wildcards `*` and `?`."},
+		"select.state":                                    {"Alternate state the object is expected to be bound to. Selections are made in the state of the object, the action fails if the object is bound to another state. Current selections of the state are tracked. Defaults to no verification of state."},
+		"select.type":                                     {"Selection type", "`randomfromall`: Randomly select within all values of the symbol table.", "`randomfromenabled`: Randomly select within the white and light grey values on the first data page.", "`randomfromexcluded`: Randomly select within the dark grey values on the first data page.", "`randomdeselect`: Randomly deselect values on the first data page.", "`values`: Select specific element values, defined by `values` array.", "`range`: Select a numeric range of a measure or of a continuous dimension axis, defined by `range`.", "`search`: Search a listbox for `search` text and accept the search result."},
 		"select.values":                                   {"Array of element values to select when using selection type `values`. These are the element values for a selection, not the values seen by the user."},
 		"select.wrap":                                     {"Wrap selection with Begin / End selection requests (`true` / `false`)."},
 		"setscript.script":                                {"Load script for the app (written as a string)."},
@@ -368,11 +530,16 @@ var (
 		"tus.chunksize":                                   {"Upload chunk size (in bytes). Defaults to 300 MiB, if omitted or zero."},
 		"tus.retries":                                     {"Number of consecutive retries, if a chunk fails to upload. Defaults to 0 (no retries), if omitted. The first retry is issued instantly, the second with a one second back-off period, the third with a two second back-off period, and so on."},
 		"tus.timeout":                                     {"Duration after which the upload times out (for example, `1h`, `30s` or `1m10s`). Valid time units are `ns`, `us` (or `µs`), `ms`, `s`, `m`, and `h`."},
+		"unlockall.state":                                 {"Alternate state to unlock selections in. Defaults to the default state (`$`)."},
+		"unlockfield.name":                                {"Name of field to unlock."},
+		"unlockfield.state":                               {"Alternate state to unlock the field selections in. Defaults to the default state (`$`)."},
 		"unpublishsheet.mode":                             {"", "`allsheets`: Unpublish all sheets in the app.", "`sheetids`: Only unpublish the sheets specified by the `sheetIds` array."},
 		"unpublishsheet.sheetIds":                         {"(optional) Array of sheet IDs for the `sheetids` mode."},
 		"unpublishsheet.thinktime":                        {"Duration to 'think' inbetween unpublishing sheets (for example, `1h`, `30s` or `1m10s`). Defaults to 100ms."},
 		"unsubscribeobjects.clear":                        {"Remove any previously subscribed objects from the subscription list."},
 		"unsubscribeobjects.ids":                          {"List of object IDs to unsubscribe from."},
+		"variableinput.id":                                {"ID of the variable input (`qlik-variable-input`) object."},
+		"variableinput.value":                             {"Value to set the variable to. Required for input box objects. Defaults to a random value of the object, chosen from the values of buttons or drop down, or from the range of a slider. Supports the use of [session variables](#session_variables)."},
 	}
 
 	Config = map[string]common.DocEntry{
@@ -402,7 +569,11 @@ var (
 		},
 		"settings": {
 			Description: "## Settings section\n\nThis section of the JSON file contains timeout and logging settings for the load scenario.\n",
-			Examples:    "### Examples\n\n```json\n\"settings\": {\n	\"timeout\": 300,\n	\"logs\": {\n		\"traffic\": false,\n		\"debug\": false,\n		\"filename\": \"logs/{{.ConfigFile}}-{{timestamp}}.log\"\n	}\n}\n```\n\n```json\n\"settings\": {\n	\"timeout\": 300,\n	\"logs\": {\n		\"filename\": \"logs/scenario.log\"\n	},\n	\"outputs\" : {\n	    \"dir\" : \"./outputs\"\n	}\n}\n```\n",
+			Examples:    "### Examples\n\n```json\n\"settings\": {\n	\"timeout\": 300,\n	\"logs\": {\n		\"traffic\": false,\n		\"debug\": false,\n		\"filename\": \"logs/{{.ConfigFile}}-{{timestamp}}.log\"\n	}\n}\n```\n\n```json\n\"settings\": {\n	\"timeout\": 300,\n	\"logs\": {\n		\"filename\": \"logs/scenario.log\"\n	},\n	\"outputs\" : {\n	    \"dir\" : \"./outputs\"\n	}\n}\n```\n\n```json\n\"settings\": {\n	\"timeout\": 300,\n	\"logs\": {\n		\"filename\": \"logs/scenario.tsv\",\n		\"interval\": {\n			\"period\": \"10s\",\n			\"filename\": \"logs/{{.ConfigFile}}-intervals.csv\",\n			\"format\": \"csv\"\n		}\n	}\n}\n```\n",
+		},
+		"thresholds": {
+			Description: "## Thresholds section\n\nThis section of the JSON file contains pass/fail thresholds evaluated against the execution statistics when the test is done. A threshold report is printed after the test and if any threshold fails, gopherciser exits with a dedicated exit code (`0x8E`), allowing e.g. CI pipelines to fail a build on performance regressions.\n\nThresholds on actions are evaluated against statistics of all actions matching the `action` and `label` filters, regardless of app. Statistics collection is turned on automatically when thresholds are defined.\n",
+			Examples:    "### Example\n\n```json\n\"thresholds\": [\n    {\n        \"type\": \"responsetime\",\n        \"action\": \"openapp\",\n        \"percentile\": 95,\n        \"limit\": \"5s\"\n    },\n    {\n        \"type\": \"errorrate\",\n        \"action\": \"changesheet\",\n        \"limit\": \"1\"\n    },\n    {\n        \"type\": \"errors\",\n        \"limit\": \"10\"\n    },\n    {\n        \"type\": \"requestrate\",\n        \"limit\": \"50\"\n    }\n]\n```\n\nThis will fail the test if the 95th percentile response time of `openapp` is 5 seconds or more, if 1% or more of `changesheet` actions failed, if a total of 10 or more errors occurred or if the total request rate was 50 requests per second or lower.\n",
 		},
 	}
 
@@ -410,7 +581,7 @@ var (
 		{
 			Name:    "commonActions",
 			Title:   "Common actions",
-			Actions: []string{"applybookmark", "askhubadvisor", "changesheet", "clearall", "clearfield", "clickactionbutton", "containertab", "createbookmark", "createsheet", "deletebookmark", "deletesheet", "disconnectapp", "disconnectenvironment", "dosave", "duplicatesheet", "getscript", "iterated", "listboxselect", "objectsearch", "openapp", "productversion", "publishbookmark", "publishsheet", "randomaction", "reload", "select", "setscript", "setscriptvar", "setsensevariable", "sheetchanger", "smartsearch", "subscribeobjects", "thinktime", "unpublishbookmark", "unpublishsheet", "unsubscribeobjects", "stepdimension"},
+			Actions: []string{"applybookmark", "askhubadvisor", "back", "assert", "changesheet", "clearall", "clearfield", "clickactionbutton", "containertab", "createapp", "createbookmark", "createsheet", "datepicker", "deleteapp", "deletebookmark", "deletesheet", "disconnectapp", "disconnectenvironment", "dosave", "forward", "drilldown", "duplicatesheet", "exportdata", "getscript", "iterated", "listboxselect", "lockall", "lockfield", "objectsearch", "openapp", "opensessionapp", "pivot", "productversion", "publishbookmark", "publishsheet", "randomaction", "reload", "restrequest", "scroll", "select", "setscript", "setscriptvar", "setsensevariable", "sheetchanger", "smartsearch", "subscribeobjects", "thinktime", "unlockall", "unlockfield", "unpublishbookmark", "unpublishsheet", "unsubscribeobjects", "variableinput", "stepdimension"},
 			DocEntry: common.DocEntry{
 				Description: "# Common actions\n\nThese actions are applicable for most types of Qlik Sense deployments.\n\n**Note:** It is recommended to prepend the actions listed here with an `openapp` action as most of them perform operations in an app context (such as making selections or changing sheets).\n",
 				Examples:    "",
@@ -419,7 +590,7 @@ var (
 		{
 			Name:    "qseowActions",
 			Title:   "Qlik Sense Enterprise on Windows (QSEoW) actions",
-			Actions: []string{"deleteodag", "generateodag", "openhub", "changestream"},
+			Actions: []string{"deleteodag", "generateodag", "openhub", "changestream", "duplicateapp", "exportapp", "importapp"},
 			DocEntry: common.DocEntry{
 				Description: "## Qlik Sense Enterprise on Windows (QSEoW) actions\n\nThese actions are only applicable to Qlik Sense Enterprise on Windows (QSEoW) deployments.\n",
 				Examples:    "",
@@ -3,6 +3,7 @@ package constant
 //NxLocalizedErrorCode
 const (
 	LocerrGenericAborted          = 15
+	LocerrAppNotFound             = 1003
 	LocerrCalcEvalConditionFailed = 7005
 )
 
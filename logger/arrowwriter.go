@@ -0,0 +1,297 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/pkg/errors"
+)
+
+type (
+	// ArrowWriter write log rows as record batches in Apache Arrow IPC stream format
+	ArrowWriter struct {
+		Out io.Writer
+		// BatchSize amount of rows buffered before written as a record batch
+		BatchSize int
+
+		lvl     LogLevel
+		builder *array.RecordBuilder
+		rows    int
+		stream  *ipc.Writer
+		buf     bytes.Buffer
+		started bool
+		mu      sync.Mutex
+	}
+
+	arrowColumn struct {
+		field       arrow.Field
+		appendValue func(builder array.Builder, msg *LogChanMsg)
+	}
+)
+
+// DefaultArrowBatchSize default amount of rows per record batch
+const DefaultArrowBatchSize = 10000
+
+var (
+	// ArrowEndOfStream marker ending an Arrow IPC stream, to be written at the end of every log segment
+	ArrowEndOfStream = []byte{0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 0x00, 0x00}
+
+	arrowColumns = []arrowColumn{
+		{arrow.Field{Name: FieldTime, Type: arrow.FixedWidthTypes.Timestamp_ns}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.TimestampBuilder).Append(arrow.Timestamp(msg.Time.UnixNano()))
+		}},
+		{arrow.Field{Name: FieldLevel, Type: arrow.BinaryTypes.String}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.StringBuilder).Append(msg.Level.String())
+		}},
+		{arrow.Field{Name: FieldMessage, Type: arrow.BinaryTypes.String}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.StringBuilder).Append(msg.Message)
+		}},
+		{arrow.Field{Name: FieldTick, Type: arrow.PrimitiveTypes.Uint64}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.Uint64Builder).Append(msg.Tick)
+		}},
+		{arrow.Field{Name: FieldAuthUser, Type: arrow.BinaryTypes.String}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.StringBuilder).Append(msg.User)
+		}},
+		{arrow.Field{Name: FieldThread, Type: arrow.PrimitiveTypes.Uint64}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.Uint64Builder).Append(msg.Thread)
+		}},
+		{arrow.Field{Name: FieldSession, Type: arrow.PrimitiveTypes.Uint64}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.Uint64Builder).Append(msg.Session)
+		}},
+		{arrow.Field{Name: FieldSessionName, Type: arrow.BinaryTypes.String}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.StringBuilder).Append(msg.SessionName)
+		}},
+		{arrow.Field{Name: FieldAppName, Type: arrow.BinaryTypes.String}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.StringBuilder).Append(msg.AppName)
+		}},
+		{arrow.Field{Name: FieldAppGUID, Type: arrow.BinaryTypes.String}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.StringBuilder).Append(msg.AppGUID)
+		}},
+		{arrow.Field{Name: FieldAction, Type: arrow.BinaryTypes.String}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.StringBuilder).Append(msg.Action)
+		}},
+		{arrow.Field{Name: FieldLabel, Type: arrow.BinaryTypes.String}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.StringBuilder).Append(msg.Label)
+		}},
+		{arrow.Field{Name: FieldActionID, Type: arrow.PrimitiveTypes.Uint64}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.Uint64Builder).Append(msg.ActionID)
+		}},
+		{arrow.Field{Name: FieldObjectType, Type: arrow.BinaryTypes.String}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.StringBuilder).Append(msg.ObjectType)
+		}},
+		{arrow.Field{Name: FieldResponseTime, Type: arrow.PrimitiveTypes.Int64}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.Int64Builder).Append(msg.ResponseTime)
+		}},
+		{arrow.Field{Name: FieldSuccess, Type: arrow.FixedWidthTypes.Boolean, Nullable: true}, func(b array.Builder, msg *LogChanMsg) {
+			// success is only defined for action results
+			if msg.Level != ResultLevel {
+				b.AppendNull()
+				return
+			}
+			b.(*array.BooleanBuilder).Append(msg.Success)
+		}},
+		{arrow.Field{Name: FieldWarnings, Type: arrow.PrimitiveTypes.Uint64}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.Uint64Builder).Append(msg.Warnings)
+		}},
+		{arrow.Field{Name: FieldErrors, Type: arrow.PrimitiveTypes.Uint64}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.Uint64Builder).Append(msg.Errors)
+		}},
+		{arrow.Field{Name: FieldStack, Type: arrow.BinaryTypes.String}, func(b array.Builder, msg *LogChanMsg) {
+			if msg.Stack == nil {
+				b.(*array.StringBuilder).Append("")
+				return
+			}
+			b.(*array.StringBuilder).Append(fmt.Sprintf("%+v", msg.Stack))
+		}},
+		{arrow.Field{Name: FieldSent, Type: arrow.PrimitiveTypes.Uint64}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.Uint64Builder).Append(msg.Sent)
+		}},
+		{arrow.Field{Name: FieldReceived, Type: arrow.PrimitiveTypes.Uint64}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.Uint64Builder).Append(msg.Received)
+		}},
+		{arrow.Field{Name: FieldDetails, Type: arrow.BinaryTypes.String}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.StringBuilder).Append(msg.Details)
+		}},
+		{arrow.Field{Name: FieldInfoType, Type: arrow.BinaryTypes.String}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.StringBuilder).Append(msg.InfoType)
+		}},
+		{arrow.Field{Name: FieldRequestsSent, Type: arrow.PrimitiveTypes.Uint64}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.Uint64Builder).Append(msg.RequestsSent)
+		}},
+		{arrow.Field{Name: FieldErrorCategory, Type: arrow.BinaryTypes.String}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.StringBuilder).Append(msg.ErrorCategory)
+		}},
+		{arrow.Field{Name: FieldErrorCode, Type: arrow.BinaryTypes.String}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.StringBuilder).Append(msg.ErrorCode)
+		}},
+		{arrow.Field{Name: FieldFingerprint, Type: arrow.BinaryTypes.String}, func(b array.Builder, msg *LogChanMsg) {
+			b.(*array.StringBuilder).Append(msg.Fingerprint)
+		}},
+	}
+
+	// ArrowSchema of log rows written by ArrowWriter
+	ArrowSchema = arrowSchema()
+)
+
+func arrowSchema() *arrow.Schema {
+	fields := make([]arrow.Field, 0, len(arrowColumns))
+	for _, column := range arrowColumns {
+		fields = append(fields, column.field)
+	}
+	return arrow.NewSchema(fields, nil)
+}
+
+// NewArrowWriter instance
+func NewArrowWriter(w io.Writer, batchSize int) (*ArrowWriter, error) {
+	if batchSize < 1 {
+		batchSize = DefaultArrowBatchSize
+	}
+
+	writer := &ArrowWriter{
+		Out:       w,
+		BatchSize: batchSize,
+		lvl:       InfoLevel,
+		builder:   array.NewRecordBuilder(memory.DefaultAllocator, ArrowSchema),
+	}
+	writer.stream = ipc.NewWriter(&writer.buf, ipc.WithSchema(ArrowSchema))
+	return writer, nil
+}
+
+// WriteMessage implement MsgWriter interface
+func (writer *ArrowWriter) WriteMessage(msg *LogChanMsg) error {
+	if writer == nil || writer.Out == nil || msg == nil {
+		return nil
+	}
+
+	writer.mu.Lock()
+	defer writer.mu.Unlock()
+
+	if writer.lvl < msg.Level || writer.builder == nil {
+		return nil
+	}
+
+	for i, column := range arrowColumns {
+		column.appendValue(writer.builder.Field(i), msg)
+	}
+	writer.rows++
+
+	if writer.rows < writer.BatchSize {
+		return nil
+	}
+	return errors.WithStack(writer.flush())
+}
+
+// Level implement MsgWriter interface
+func (writer *ArrowWriter) Level(lvl LogLevel) {
+	writer.mu.Lock()
+	defer writer.mu.Unlock()
+	writer.lvl = lvl
+}
+
+// Close writes buffered rows and ends stream, does not close Out
+func (writer *ArrowWriter) Close() error {
+	if writer == nil {
+		return nil
+	}
+
+	writer.mu.Lock()
+	defer writer.mu.Unlock()
+
+	if writer.builder == nil {
+		return nil
+	}
+
+	// last record batch and end of stream are written together to not start a new segment for end of stream only
+	writer.buf.Reset()
+	err := writer.encode()
+	writer.builder.Release()
+	writer.builder = nil
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := writer.stream.Close(); err != nil {
+		return errors.Wrap(err, "failed to end arrow stream")
+	}
+	return errors.WithStack(writer.write(writer.buf.Bytes()))
+}
+
+// flush buffered rows as a record batch, should be called with lock held
+func (writer *ArrowWriter) flush() error {
+	writer.buf.Reset()
+	if err := writer.encode(); err != nil {
+		return errors.WithStack(err)
+	}
+	if writer.buf.Len() < 1 {
+		return nil
+	}
+	return errors.WithStack(writer.write(writer.buf.Bytes()))
+}
+
+// encode buffered rows as a record batch appended to buf
+func (writer *ArrowWriter) encode() error {
+	if writer.rows < 1 {
+		return nil
+	}
+
+	record := writer.builder.NewRecordBatch()
+	defer record.Release()
+	writer.rows = 0
+
+	if err := writer.stream.Write(record); err != nil {
+		return errors.Wrap(err, "failed to encode arrow record batch")
+	}
+	return nil
+}
+
+// write encoded stream data to Out, the stream header is written separately from first record batch, this makes
+// it possible to repeat the header at start of every log segment
+func (writer *ArrowWriter) write(data []byte) error {
+	if !writer.started {
+		writer.started = true
+		headerLen, err := arrowSchemaLen(data)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if len(data) > headerLen {
+			if _, err := writer.Out.Write(data[:headerLen]); err != nil {
+				return errors.Wrap(err, "failed to write arrow stream header")
+			}
+			data = data[headerLen:]
+		}
+	}
+	if _, err := writer.Out.Write(data); err != nil {
+		return errors.Wrap(err, "failed to write arrow record batch")
+	}
+	return nil
+}
+
+// arrowSchemaLen length of schema message starting stream data. The length is read from the message framing, a
+// continuation marker followed by metadata length, rather than from when the stream writer encodes the schema.
+func arrowSchemaLen(data []byte) (int, error) {
+	if len(data) < 8 || !bytes.Equal(data[:4], ArrowEndOfStream[:4]) {
+		return 0, errors.New("arrow stream does not start with an encapsulated message")
+	}
+	headerLen := 8 + int(binary.LittleEndian.Uint32(data[4:8]))
+	if headerLen > len(data) {
+		return 0, errors.Errorf("arrow stream message length<%d> exceeds data length<%d>", headerLen, len(data))
+	}
+
+	// schema messages have no body, i.e. the message ends after its metadata
+	reader := ipc.NewMessageReader(bytes.NewReader(data[:headerLen]))
+	defer reader.Release()
+	msg, err := reader.Message()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to decode arrow stream header")
+	}
+	if msg.Type() != ipc.MessageSchema {
+		return 0, errors.Errorf("arrow stream starts with message type<%s>, expected schema", msg.Type())
+	}
+	return headerLen, nil
+}
@@ -0,0 +1,165 @@
+package logger
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/stretchr/testify/assert"
+)
+
+func arrowTestMsg(i int, level LogLevel) *LogChanMsg {
+	msg := NewEmptyLogChanMsg()
+	msg.Time = time.Unix(1600000000, int64(i))
+	msg.Level = level
+	msg.Message = "message"
+	msg.Action = "openapp"
+	msg.ActionID = uint64(i)
+	msg.Success = true
+	return msg
+}
+
+// readArrowStream returns action ID and success column of all rows in stream
+func readArrowStream(t *testing.T, r io.Reader) ([]uint64, []string) {
+	t.Helper()
+	reader, err := ipc.NewReader(r)
+	if !assert.NoError(t, err) {
+		return nil, nil
+	}
+	defer reader.Release()
+	assert.True(t, reader.Schema().Equal(ArrowSchema))
+
+	actionIDIdx := reader.Schema().FieldIndices(FieldActionID)[0]
+	successIdx := reader.Schema().FieldIndices(FieldSuccess)[0]
+	var ids []uint64
+	var success []string
+	for reader.Next() {
+		record := reader.RecordBatch()
+		idCol := record.Column(actionIDIdx).(*array.Uint64)
+		successCol := record.Column(successIdx).(*array.Boolean)
+		for i := 0; i < int(record.NumRows()); i++ {
+			ids = append(ids, idCol.Value(i))
+			switch {
+			case successCol.IsNull(i):
+				success = append(success, "null")
+			case successCol.Value(i):
+				success = append(success, "true")
+			default:
+				success = append(success, "false")
+			}
+		}
+	}
+	assert.NoError(t, reader.Err())
+	return ids, success
+}
+
+func TestArrowWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewArrowWriter(&buf, 2)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.NoError(t, w.WriteMessage(arrowTestMsg(1, ResultLevel)))
+	assert.NoError(t, w.WriteMessage(arrowTestMsg(2, InfoLevel)))
+	assert.NoError(t, w.WriteMessage(arrowTestMsg(3, DebugLevel))) // filtered on info level
+	assert.NoError(t, w.WriteMessage(arrowTestMsg(4, ErrorLevel)))
+	assert.NoError(t, w.Close())
+
+	ids, success := readArrowStream(t, &buf)
+	assert.Equal(t, []uint64{1, 2, 4}, ids)
+	assert.Equal(t, []string{"true", "null", "null"}, success)
+}
+
+func TestArrowWriterEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewArrowWriter(&buf, 2)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, w.Close())
+
+	ids, _ := readArrowStream(t, &buf)
+	assert.Empty(t, ids)
+}
+
+type arrowWrites [][]byte
+
+func (writes *arrowWrites) Write(p []byte) (int, error) {
+	*writes = append(*writes, append([]byte(nil), p...))
+	return len(p), nil
+}
+
+func TestArrowWriterHeader(t *testing.T) {
+	var writes arrowWrites
+	w, err := NewArrowWriter(&writes, 1)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, w.WriteMessage(arrowTestMsg(1, ResultLevel)))
+	if !assert.Len(t, writes, 2) {
+		return
+	}
+
+	// header written separately is a complete stream when followed by end of stream
+	header := append(append([]byte(nil), writes[0]...), ArrowEndOfStream...)
+	ids, _ := readArrowStream(t, bytes.NewReader(header))
+	assert.Empty(t, ids)
+
+	_, err = arrowSchemaLen(writes[1])
+	assert.Error(t, err, "record batch taken for stream header")
+}
+
+func TestArrowWriterRotation(t *testing.T) {
+	dir := t.TempDir()
+	fw, err := NewRotatingWriter(RotationSettings{
+		MaxSize:      1,
+		RepeatHeader: true,
+		Footer:       ArrowEndOfStream,
+	}, func(segment int) (string, error) {
+		return filepath.Join(dir, "log.arrow"), nil
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	w, err := NewArrowWriter(fw, 2)
+	if !assert.NoError(t, err) {
+		return
+	}
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, w.WriteMessage(arrowTestMsg(i, ResultLevel)))
+	}
+	assert.NoError(t, w.Close())
+	assert.NoError(t, fw.Close())
+
+	// every record batch exceeds max size, i.e. one batch per segment
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+	assert.Equal(t, []string{"log-001.arrow", "log-002.arrow", "log.arrow"}, names)
+
+	expected := map[string][]uint64{
+		"log.arrow":     {0, 1},
+		"log-001.arrow": {2, 3},
+		"log-002.arrow": {4},
+	}
+	for name, expectedIDs := range expected {
+		f, err := os.Open(filepath.Join(dir, name))
+		if !assert.NoError(t, err) {
+			continue
+		}
+		ids, _ := readArrowStream(t, f)
+		assert.Equal(t, expectedIDs, ids, name)
+		assert.NoError(t, f.Close())
+	}
+}
@@ -41,6 +41,12 @@ type (
 		Details      string
 		InfoType     string
 		RequestsSent uint64
+		// ErrorCategory, ErrorCode and Fingerprint classifies error level entries
+		ErrorCategory string
+		ErrorCode     string
+		Fingerprint   string
+		// ErrorMessage normalized message of error level entries, not written to logs
+		ErrorMessage string
 	}
 
 	//LogEntry entry used for logging
@@ -263,6 +269,14 @@ func (entry *LogEntry) log(level LogLevel, msg string, eph *ephemeralEntry) {
 		eph = &ephemeralEntry{}
 	}
 
+	if level == ErrorLevel && eph.Fingerprint == "" {
+		class := ClassifyError(msg, eph.Stack)
+		eph.ErrorCategory = class.Category.String()
+		eph.ErrorCode = class.Code
+		eph.Fingerprint = class.Fingerprint
+		eph.ErrorMessage = class.Message
+	}
+
 	chanMsg := &LogChanMsg{m, s, a, eph}
 
 	if entry.interceptors[level] != nil {
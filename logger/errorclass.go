@@ -0,0 +1,178 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"regexp"
+	"strconv"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/qlik-oss/gopherciser/enummap"
+	"github.com/qlik-oss/gopherciser/globals/constant"
+)
+
+type (
+	// ErrorCategory category of a logged error
+	ErrorCategory int
+
+	// ErrorCategorizer can be implemented by errors to define their own category
+	ErrorCategorizer interface {
+		ErrorCategory() ErrorCategory
+	}
+
+	// ErrorClass classification of a logged error
+	ErrorClass struct {
+		Category ErrorCategory
+		// Code engine error code or HTTP status code, empty if not applicable
+		Code string
+		// Fingerprint identifying similar errors
+		Fingerprint string
+		// Message with variable parts such as IDs and numbers replaced
+		Message string
+	}
+
+	// engineError implemented by engine errors, e.g. enigma.Error
+	engineError interface {
+		Code() int
+	}
+
+	// statusCodeError implemented by errors caused by unexpected HTTP status codes
+	statusCodeError interface {
+		StatusCode() int
+	}
+
+	// timeoutError implemented by e.g. net.Error
+	timeoutError interface {
+		Timeout() bool
+	}
+)
+
+// ErrorCategory enum
+const (
+	ErrorCategoryOther ErrorCategory = iota
+	ErrorCategoryEngine
+	ErrorCategoryHTTP
+	ErrorCategoryDisconnect
+	ErrorCategoryTimeout
+	ErrorCategoryValidation
+	ErrorCategoryAppNotFound
+)
+
+var (
+	errorCategoryEnum = enummap.NewEnumMapOrPanic(map[string]int{
+		"other":       int(ErrorCategoryOther),
+		"engine":      int(ErrorCategoryEngine),
+		"http":        int(ErrorCategoryHTTP),
+		"disconnect":  int(ErrorCategoryDisconnect),
+		"timeout":     int(ErrorCategoryTimeout),
+		"validation":  int(ErrorCategoryValidation),
+		"appnotfound": int(ErrorCategoryAppNotFound),
+	})
+
+	uuidRegex       = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+	bracketRegex    = regexp.MustCompile(`<[^<>]*>`)
+	plainValueRegex = regexp.MustCompile(`^<[a-z_\- ]*>$`)
+	numberRegex     = regexp.MustCompile(`[0-9]+`)
+)
+
+// GetEnumMap of ErrorCategory
+func (category ErrorCategory) GetEnumMap() *enummap.EnumMap {
+	return errorCategoryEnum
+}
+
+// String representation of ErrorCategory
+func (category ErrorCategory) String() string {
+	return errorCategoryEnum.StringDefault(int(category), strconv.Itoa(int(category)))
+}
+
+// MarshalJSON marshal ErrorCategory
+func (category ErrorCategory) MarshalJSON() ([]byte, error) {
+	str, err := errorCategoryEnum.String(int(category))
+	if err != nil {
+		return nil, pkgerrors.Errorf("Unknown ErrorCategory<%d>", category)
+	}
+	return []byte(fmt.Sprintf(`"%s"`, str)), nil
+}
+
+// UnmarshalJSON ErrorCategory
+func (category *ErrorCategory) UnmarshalJSON(arg []byte) error {
+	i, err := errorCategoryEnum.UnMarshal(arg)
+	if err != nil {
+		return pkgerrors.Wrap(err, "Failed to unmarshal ErrorCategory")
+	}
+	*category = ErrorCategory(i)
+	return nil
+}
+
+// ClassifyError into category and fingerprint. msg is the logged message, which is used for fingerprint together
+// with error message when it differs.
+func ClassifyError(msg string, err error) ErrorClass {
+	class := ErrorClass{}
+	if err != nil {
+		class.Category, class.Code = errorCategory(err)
+		if errMsg := err.Error(); errMsg != msg {
+			if msg == "" {
+				msg = errMsg
+			} else {
+				msg = msg + ": " + errMsg
+			}
+		}
+	}
+
+	class.Message = normalizeErrorMessage(msg)
+
+	hash := fnv.New64a()
+	_, _ = fmt.Fprintf(hash, "%d\x00%s\x00%s", class.Category, class.Code, class.Message)
+	class.Fingerprint = fmt.Sprintf("%016x", hash.Sum64())
+	return class
+}
+
+func errorCategory(err error) (ErrorCategory, string) {
+	code := ""
+	var statusErr statusCodeError
+	if errors.As(err, &statusErr) {
+		code = strconv.Itoa(statusErr.StatusCode())
+	}
+	var engineErr engineError
+	if code == "" && errors.As(err, &engineErr) {
+		code = strconv.Itoa(engineErr.Code())
+	}
+
+	var categorizer ErrorCategorizer
+	if errors.As(err, &categorizer) {
+		return categorizer.ErrorCategory(), code
+	}
+
+	var timeoutErr timeoutError
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, os.ErrDeadlineExceeded) ||
+		(errors.As(err, &timeoutErr) && timeoutErr.Timeout()) {
+		return ErrorCategoryTimeout, code
+	}
+
+	switch {
+	case statusErr != nil:
+		return ErrorCategoryHTTP, code
+	case engineErr != nil && engineErr.Code() == constant.LocerrAppNotFound:
+		return ErrorCategoryAppNotFound, code
+	case engineErr != nil:
+		return ErrorCategoryEngine, code
+	}
+	return ErrorCategoryOther, code
+}
+
+// normalizeErrorMessage replaces variable parts of message, such as GUIDs, numbers and object IDs, to make similar
+// errors get the same fingerprint
+func normalizeErrorMessage(msg string) string {
+	msg = uuidRegex.ReplaceAllString(msg, "*")
+	msg = bracketRegex.ReplaceAllStringFunc(msg, func(value string) string {
+		if plainValueRegex.MatchString(value) {
+			// keep e.g. action names and object types
+			return value
+		}
+		return "<*>"
+	})
+	return numberRegex.ReplaceAllString(msg, "#")
+}
@@ -0,0 +1,72 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	testEngineError    int
+	testStatusError    int
+	testCategorizedErr struct{}
+)
+
+func (err testEngineError) Error() string { return fmt.Sprintf("engine error<%d>", int(err)) }
+func (err testEngineError) Code() int     { return int(err) }
+
+func (err testStatusError) Error() string   { return fmt.Sprintf("status code<%d>", int(err)) }
+func (err testStatusError) StatusCode() int { return int(err) }
+
+func (err testCategorizedErr) Error() string                { return "invalid object" }
+func (err testCategorizedErr) ErrorCategory() ErrorCategory { return ErrorCategoryValidation }
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		err      error
+		category ErrorCategory
+		code     string
+	}{
+		{errors.New("something went wrong"), ErrorCategoryOther, ""},
+		{errors.Wrap(testEngineError(2), "failed to get layout"), ErrorCategoryEngine, "2"},
+		{errors.WithStack(testEngineError(1003)), ErrorCategoryAppNotFound, "1003"},
+		{errors.Wrap(testStatusError(503), "request failed"), ErrorCategoryHTTP, "503"},
+		{errors.Wrap(context.DeadlineExceeded, "waiting for response"), ErrorCategoryTimeout, ""},
+		{errors.WithStack(testCategorizedErr{}), ErrorCategoryValidation, ""},
+	}
+
+	for _, test := range tests {
+		class := ClassifyError(test.err.Error(), test.err)
+		if class.Category != test.category || class.Code != test.code {
+			t.Errorf("error<%v> expected category<%s> code<%s> got category<%s> code<%s>", test.err,
+				test.category, test.code, class.Category, class.Code)
+		}
+	}
+}
+
+func TestErrorFingerprint(t *testing.T) {
+	class1 := ClassifyError("object<aBcD12> in app<dc2c9170-871d-4093-b4cf-df6c1fcb1c01> failed after 13 retries", nil)
+	class2 := ClassifyError("object<xYz987> in app<aaaaaaaa-871d-4093-b4cf-df6c1fcb1c01> failed after 2 retries", nil)
+	if class1.Fingerprint != class2.Fingerprint {
+		t.Errorf("expected same fingerprint of<%s> and<%s>", class1.Message, class2.Message)
+	}
+	if expected := "object<*> in app<*> failed after # retries"; class1.Message != expected {
+		t.Errorf("unexpected normalized message<%s> expected<%s>", class1.Message, expected)
+	}
+
+	// plain values such as action names are kept
+	class3 := ClassifyError("action<openapp> failed", nil)
+	class4 := ClassifyError("action<changesheet> failed", nil)
+	if class3.Fingerprint == class4.Fingerprint {
+		t.Error("expected different fingerprints for different actions")
+	}
+
+	// same message with different category gives different fingerprint
+	class5 := ClassifyError("engine error<2>", testEngineError(2))
+	class6 := ClassifyError("engine error<2>", nil)
+	if class5.Fingerprint == class6.Fingerprint {
+		t.Error("expected different fingerprints for different categories")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"sync"
 
 	"github.com/pkg/errors"
 )
@@ -12,7 +13,9 @@ import (
 type (
 	// Writer simple implementation of a file writer
 	Writer struct {
-		fil *os.File
+		fil      *os.File
+		rotation *rotation
+		mu       sync.Mutex
 	}
 )
 
@@ -36,12 +39,28 @@ func NewWriter(name string) (*Writer, error) {
 }
 
 // Write implement io.Writer interface
-func (w Writer) Write(p []byte) (int, error) {
+func (w *Writer) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
 	if w.fil == nil {
 		return 0, nil
 	}
 
+	if w.rotation != nil {
+		if w.rotation.settings.RepeatHeader && w.rotation.header == nil {
+			w.rotation.header = append([]byte{}, p...)
+		} else if w.rotation.shouldRotate(len(p)) {
+			if err := w.rotate(); err != nil {
+				return 0, errors.WithStack(err)
+			}
+		}
+	}
+
 	n, err := w.fil.Write(p)
+	if w.rotation != nil {
+		w.rotation.size += int64(n)
+	}
 	if err != nil {
 		return n, errors.WithStack(err)
 	}
@@ -65,11 +84,22 @@ func (w *Writer) createFile(name string) error {
 
 // Close writer
 func (w *Writer) Close() error {
-	if w.fil == nil {
-		return nil
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var err error
+	if w.fil != nil {
+		err = w.fil.Close()
+		w.fil = nil
+	}
+	if w.rotation != nil {
+		if rotationErr := w.rotation.close(); err == nil {
+			err = rotationErr
+		}
+		w.rotation = nil
 	}
 
-	return errors.WithStack(w.fil.Close())
+	return errors.WithStack(err)
 }
 
 func backupName(name string) string {
@@ -100,6 +100,11 @@ func setEphemeralFields(ev *zerolog.Event, e *ephemeralEntry, level LogLevel) {
 	}
 	ev.Uint64(FieldWarnings, e.Warnings)
 	ev.Int64(FieldResponseTime, e.ResponseTime)
+	if e.Fingerprint != "" {
+		ev.Str(FieldErrorCategory, e.ErrorCategory)
+		ev.Str(FieldErrorCode, e.ErrorCode)
+		ev.Str(FieldFingerprint, e.Fingerprint)
+	}
 }
 
 func setMessageFields(ev *zerolog.Event, m *message) {
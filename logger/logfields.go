@@ -43,6 +43,12 @@ const (
 	FieldInfoType = "InfoType"
 	// FieldRequestsSent - request counter
 	FieldRequestsSent = "RequestsSent"
+	// FieldErrorCategory - category of error
+	FieldErrorCategory = "ErrorCategory"
+	// FieldErrorCode - engine error code or HTTP status code of error
+	FieldErrorCode = "ErrorCode"
+	// FieldFingerprint - fingerprint identifying similar errors
+	FieldFingerprint = "Fingerprint"
 	// FieldTime - logging time
 	FieldTime = "time"
 	// FieldTimestamp - to be used for time without timezone for G3 compliance
@@ -57,5 +63,6 @@ var (
 	//AllFields for logging (i.e. use as headers)
 	AllFields = []string{FieldTime, FieldAction, FieldLabel, FieldActionID, FieldLevel, FieldInfoType, FieldMessage, FieldDetails, FieldSuccess, FieldResponseTime,
 		FieldAppName, FieldAppGUID, FieldAuthUser, FieldThread, FieldSession, FieldSessionName, FieldTick,
-		FieldObjectType, FieldWarnings, FieldErrors, FieldStack, FieldSent, FieldReceived, FieldRequestsSent, FieldTimestamp}
+		FieldObjectType, FieldWarnings, FieldErrors, FieldStack, FieldSent, FieldReceived, FieldRequestsSent, FieldTimestamp,
+		FieldErrorCategory, FieldErrorCode, FieldFingerprint}
 )
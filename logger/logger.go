@@ -350,6 +350,21 @@ func CreateTSVLogger(header []string, writer io.Writer, closeFunc func() error)
 	return tsvLogger, nil
 }
 
+// CreateArrowLogger with io.Writer, rows are written in Apache Arrow IPC stream format
+func CreateArrowLogger(writer io.Writer, closeFunc func() error) (*Logger, error) {
+	arrowWriter, err := NewArrowWriter(writer, DefaultArrowBatchSize)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	arrowLogger := NewLogger(arrowWriter)
+	// buffered rows needs to be written before closing writer
+	arrowLogger.AddCloseFunc(arrowWriter.Close)
+	if closeFunc != nil {
+		arrowLogger.AddCloseFunc(closeFunc)
+	}
+	return arrowLogger, nil
+}
+
 // CreateStdoutLogger create logger for JSON on terminal for later adding to loggers list
 func CreateStdoutLogger() *Logger {
 	zlgr := zerolog.New(zerolog.ConsoleWriter{
@@ -0,0 +1,362 @@
+package logger
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/gopherciser/enummap"
+)
+
+type (
+	// CompressionType compression of rotated log segments
+	CompressionType int
+
+	// RotationSettings settings for rotating log files into segments
+	RotationSettings struct {
+		// MaxSize of a segment in bytes, 0 disables size based rotation
+		MaxSize int64
+		// Interval after which a new segment is started, 0 disables time based rotation
+		Interval time.Duration
+		// Compression of rotated segments
+		Compression CompressionType
+		// Retention is the amount of rotated segments kept, older segments are removed. 0 keeps all segments.
+		Retention int
+		// RepeatHeader writes the first write to the file at the start of every segment, e.g. a TSV header row
+		RepeatHeader bool
+		// Footer written at the end of every rotated segment, e.g. an end of stream marker
+		Footer []byte
+	}
+
+	// SegmentNameFunc returns file name of segment, the first segment has index 0
+	SegmentNameFunc func(segment int) (string, error)
+)
+
+// CompressionType enum
+const (
+	CompressionNone CompressionType = iota
+	CompressionGzip
+	CompressionZstd
+)
+
+var compressionTypeEnum = enummap.NewEnumMapOrPanic(map[string]int{
+	"none": int(CompressionNone),
+	"gzip": int(CompressionGzip),
+	"zstd": int(CompressionZstd),
+})
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// GetEnumMap of CompressionType for GUI
+func (compression CompressionType) GetEnumMap() *enummap.EnumMap {
+	return compressionTypeEnum
+}
+
+// UnmarshalJSON CompressionType
+func (compression *CompressionType) UnmarshalJSON(arg []byte) error {
+	i, err := compressionTypeEnum.UnMarshal(arg)
+	if err != nil {
+		return errors.Wrap(err, "Failed to unmarshal CompressionType")
+	}
+	*compression = CompressionType(i)
+	return nil
+}
+
+// MarshalJSON marshal CompressionType
+func (compression CompressionType) MarshalJSON() ([]byte, error) {
+	str, err := compressionTypeEnum.String(int(compression))
+	if err != nil {
+		return nil, errors.Errorf("Unknown CompressionType<%d>", compression)
+	}
+	return []byte(fmt.Sprintf(`"%s"`, str)), nil
+}
+
+// Extension added to file name of segments compressed with compression
+func (compression CompressionType) Extension() string {
+	switch compression {
+	case CompressionGzip:
+		return ".gz"
+	case CompressionZstd:
+		return ".zst"
+	default:
+		return ""
+	}
+}
+
+// Enabled returns true if either size or time based rotation is configured
+func (settings RotationSettings) Enabled() bool {
+	return settings.MaxSize > 0 || settings.Interval > 0
+}
+
+// Validate rotation settings
+func (settings RotationSettings) Validate() error {
+	if settings.MaxSize < 0 {
+		return errors.Errorf("negative log rotation size<%d>", settings.MaxSize)
+	}
+	if settings.Interval < 0 {
+		return errors.Errorf("negative log rotation interval<%v>", settings.Interval)
+	}
+	if settings.Retention < 0 {
+		return errors.Errorf("negative log rotation retention<%d>", settings.Retention)
+	}
+	if _, err := compressionTypeEnum.String(int(settings.Compression)); err != nil {
+		return errors.Errorf("unknown log compression<%d>", settings.Compression)
+	}
+	return nil
+}
+
+// NewRotatingWriter creates a Writer which starts a new segment, named by segmentName, when the current segment
+// exceeds the size or age defined in settings. Rotated segments are compressed and pruned in the background.
+func NewRotatingWriter(settings RotationSettings, segmentName SegmentNameFunc) (*Writer, error) {
+	if err := settings.Validate(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	name, err := segmentName(0)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	w, err := NewWriter(name)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if !settings.Enabled() {
+		return w, nil
+	}
+
+	w.rotation = &rotation{
+		settings:     settings,
+		segmentName:  segmentName,
+		firstName:    name,
+		segmentStart: time.Now(),
+		rotated:      make(chan string, 16),
+		done:         make(chan struct{}),
+	}
+	go w.rotation.archive()
+	return w, nil
+}
+
+type rotation struct {
+	settings     RotationSettings
+	segmentName  SegmentNameFunc
+	firstName    string
+	segment      int
+	segmentStart time.Time
+	size         int64
+	header       []byte
+
+	rotated chan string
+	done    chan struct{}
+
+	errLock sync.Mutex
+	err     error
+}
+
+// shouldRotate returns true if writing n bytes should be done to a new segment
+func (r *rotation) shouldRotate(n int) bool {
+	if r.size < 1 || (r.header != nil && r.size <= int64(len(r.header))) {
+		// never rotate empty segments
+		return false
+	}
+	if r.settings.MaxSize > 0 && r.size+int64(n) > r.settings.MaxSize {
+		return true
+	}
+	return r.settings.Interval > 0 && time.Since(r.segmentStart) >= r.settings.Interval
+}
+
+// nextName returns name of next segment, a -NNN suffix is added when segment name doesn't change with segment index
+func (r *rotation) nextName() (string, error) {
+	r.segment++
+	name, err := r.segmentName(r.segment)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	if name == r.firstName {
+		ext := filepath.Ext(name)
+		name = fmt.Sprintf("%s-%03d%s", name[:len(name)-len(ext)], r.segment, ext)
+	}
+	return name, nil
+}
+
+// archive compresses rotated segments and removes segments exceeding retention count
+func (r *rotation) archive() {
+	defer close(r.done)
+	kept := make([]string, 0, r.settings.Retention+1)
+	for name := range r.rotated {
+		if r.settings.Compression != CompressionNone {
+			compressed, err := compressFile(name, r.settings.Compression)
+			if err != nil {
+				r.setErr(err)
+			} else {
+				name = compressed
+			}
+		}
+
+		if r.settings.Retention < 1 {
+			continue
+		}
+		kept = append(kept, name)
+		for len(kept) > r.settings.Retention {
+			if err := os.Remove(kept[0]); err != nil && !os.IsNotExist(err) {
+				r.setErr(errors.Wrapf(err, "failed to remove log segment<%s>", kept[0]))
+			}
+			kept = kept[1:]
+		}
+	}
+}
+
+func (r *rotation) setErr(err error) {
+	r.errLock.Lock()
+	defer r.errLock.Unlock()
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+// close waits for archiving of rotated segments and returns first archiving error
+func (r *rotation) close() error {
+	close(r.rotated)
+	<-r.done
+	r.errLock.Lock()
+	defer r.errLock.Unlock()
+	return r.err
+}
+
+// rotate closes current segment and starts a new one, should be called with writer lock held
+func (w *Writer) rotate() error {
+	name, err := w.rotation.nextName()
+	if err != nil {
+		return errors.Wrap(err, "failed to get name of log segment")
+	}
+
+	closed := w.fil.Name()
+	if len(w.rotation.settings.Footer) > 0 {
+		if _, err := w.fil.Write(w.rotation.settings.Footer); err != nil {
+			return errors.Wrapf(err, "failed to write footer of log segment<%s>", closed)
+		}
+	}
+	if err := w.fil.Close(); err != nil {
+		return errors.Wrapf(err, "failed to close log segment<%s>", closed)
+	}
+	w.fil = nil
+	w.rotation.rotated <- closed
+
+	if err := w.createFile(name); err != nil {
+		return errors.WithStack(err)
+	}
+	w.rotation.size = 0
+	w.rotation.segmentStart = time.Now()
+
+	if w.rotation.header != nil {
+		n, err := w.fil.Write(w.rotation.header)
+		w.rotation.size += int64(n)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
+
+// compressFile compresses file into a new file with compression extension added and removes the original
+func compressFile(name string, compression CompressionType) (string, error) {
+	compressedName := name + compression.Extension()
+
+	src, err := os.Open(name)
+	if err != nil {
+		return name, errors.Wrapf(err, "failed to open log segment<%s>", name)
+	}
+	defer func() { _ = src.Close() }()
+
+	dst, err := os.Create(compressedName)
+	if err != nil {
+		return name, errors.Wrapf(err, "failed to create compressed log segment<%s>", compressedName)
+	}
+
+	var zw io.WriteCloser
+	switch compression {
+	case CompressionGzip:
+		zw = gzip.NewWriter(dst)
+	case CompressionZstd:
+		zw, err = zstd.NewWriter(dst)
+		if err != nil {
+			_ = dst.Close()
+			return name, errors.WithStack(err)
+		}
+	default:
+		_ = dst.Close()
+		return name, errors.Errorf("unknown log compression<%d>", compression)
+	}
+
+	_, err = io.Copy(zw, src)
+	if closeErr := zw.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(compressedName)
+		return name, errors.Wrapf(err, "failed to compress log segment<%s>", name)
+	}
+
+	_ = src.Close()
+	if err := os.Remove(name); err != nil {
+		return compressedName, errors.Wrapf(err, "failed to remove compressed log segment<%s>", name)
+	}
+	return compressedName, nil
+}
+
+type readCloser struct {
+	io.Reader
+	close func() error
+}
+
+func (rc readCloser) Close() error {
+	return rc.close()
+}
+
+// OpenFile opens a log file for reading, gzip and zstd compressed files are decompressed transparently
+func OpenFile(name string) (io.ReadCloser, error) {
+	fil, err := os.Open(name)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	reader := bufio.NewReader(fil)
+	magic, _ := reader.Peek(len(zstdMagic))
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		zr, err := gzip.NewReader(reader)
+		if err != nil {
+			_ = fil.Close()
+			return nil, errors.Wrapf(err, "failed to read gzip compressed file<%s>", name)
+		}
+		return readCloser{Reader: zr, close: func() error {
+			_ = zr.Close()
+			return fil.Close()
+		}}, nil
+	case bytes.HasPrefix(magic, zstdMagic):
+		zr, err := zstd.NewReader(reader)
+		if err != nil {
+			_ = fil.Close()
+			return nil, errors.Wrapf(err, "failed to read zstd compressed file<%s>", name)
+		}
+		return readCloser{Reader: zr, close: func() error {
+			zr.Close()
+			return fil.Close()
+		}}, nil
+	default:
+		return readCloser{Reader: reader, close: fil.Close}, nil
+	}
+}
@@ -0,0 +1,81 @@
+package logger
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotatingWriter(t *testing.T) {
+	for _, compression := range []CompressionType{CompressionNone, CompressionGzip, CompressionZstd} {
+		name, _ := compression.GetEnumMap().String(int(compression))
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			base := filepath.Join(dir, "log.tsv")
+			w, err := NewRotatingWriter(RotationSettings{
+				MaxSize:      20,
+				Compression:  compression,
+				Retention:    2,
+				RepeatHeader: true,
+			}, func(segment int) (string, error) {
+				return base, nil
+			})
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			_, err = w.Write([]byte("header\n"))
+			assert.NoError(t, err)
+			for i := 0; i < 8; i++ {
+				_, err = w.Write([]byte(fmt.Sprintf("row%d\n", i)))
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, w.Close())
+
+			// segments of 20 bytes hold header and 2 rows, i.e. 4 segments of which 2 rotated segments are kept
+			ext := compression.Extension()
+			expected := []string{"log-001.tsv" + ext, "log-002.tsv" + ext, "log-003.tsv"}
+			entries, err := os.ReadDir(dir)
+			assert.NoError(t, err)
+			names := make([]string, 0, len(entries))
+			for _, entry := range entries {
+				names = append(names, entry.Name())
+			}
+			sort.Strings(names)
+			assert.Equal(t, expected, names)
+
+			for i, name := range expected {
+				r, err := OpenFile(filepath.Join(dir, name))
+				if !assert.NoError(t, err) {
+					continue
+				}
+				content, err := io.ReadAll(r)
+				assert.NoError(t, err)
+				assert.NoError(t, r.Close())
+				assert.Equal(t, fmt.Sprintf("header\nrow%d\nrow%d\n", i*2+2, i*2+3), string(content))
+			}
+		})
+	}
+}
+
+func TestAddSegmentSuffix(t *testing.T) {
+	r := &rotation{
+		firstName: "logs/out.json",
+		segmentName: func(segment int) (string, error) {
+			if segment == 2 {
+				return fmt.Sprintf("logs/out-seg%d.json", segment), nil
+			}
+			return "logs/out.json", nil
+		},
+	}
+	for _, expected := range []string{"logs/out-001.json", "logs/out-seg2.json"} {
+		name, err := r.nextName()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, name)
+	}
+}
@@ -130,6 +130,12 @@ func (writer *TSVWriter) WriteMessage(msg *LogChanMsg) error {
 			buf.WriteString(replacer.Replace(msg.InfoType))
 		case FieldRequestsSent:
 			buf.WriteString(strconv.FormatUint(msg.RequestsSent, 10))
+		case FieldErrorCategory:
+			buf.WriteString(msg.ErrorCategory)
+		case FieldErrorCode:
+			buf.WriteString(msg.ErrorCode)
+		case FieldFingerprint:
+			buf.WriteString(msg.Fingerprint)
 		case FieldTime:
 			buf.WriteString(msg.Time.Format(time.RFC3339Nano))
 		case FieldTimestamp:
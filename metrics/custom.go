@@ -94,6 +94,17 @@ var GopherActionLatencyHist = prometheus.NewHistogramVec(
 	[]string{"action"},
 )
 
+// GopherEngineRequestDuration histogram tracking the response times of engine requests per method
+var GopherEngineRequestDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Namespace: promNS,
+		Name:      "engine_request_duration_seconds",
+		Help:      "latency of engine requests per method",
+		Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	},
+	[]string{"method"},
+)
+
 // BuildInfo -
 var BuildInfo = prometheus.NewGaugeVec(
 	prometheus.GaugeOpts{
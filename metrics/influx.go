@@ -0,0 +1,232 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// influxReporter aggregates metrics in memory and pushes them using InfluxDB line protocol over HTTP. Counters
+	// are written as cumulative totals, timings as count, sum, min, max and mean of the last interval.
+	influxReporter struct {
+		client *http.Client
+		target string
+		token  string
+		tags   []Tag
+
+		mu     sync.Mutex
+		series map[string]*influxSeries
+		// order of series keys, keeps written lines in a stable order
+		order []string
+
+		stop chan struct{}
+		done chan struct{}
+	}
+
+	influxSeries struct {
+		measurement string
+		tags        string
+		kind        influxKind
+
+		count    int64
+		value    float64
+		sum      float64
+		min, max float64
+	}
+
+	influxKind int
+)
+
+const (
+	influxCounter influxKind = iota
+	influxGauge
+	influxTiming
+)
+
+var (
+	influxMeasurementEscaper = strings.NewReplacer(",", `\,`, " ", `\ `, "\n", "")
+	influxTagEscaper         = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `, "\n", "")
+)
+
+func newInfluxReporter(settings ReporterSettings) (*influxReporter, error) {
+	u, err := url.Parse(settings.Target)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, errors.Errorf("InfluxDB target<%s> is not a valid URL", settings.Target)
+	}
+
+	reporter := &influxReporter{
+		client: &http.Client{Timeout: 30 * time.Second},
+		target: settings.Target,
+		token:  settings.Token,
+		tags:   settings.Tags,
+		series: make(map[string]*influxSeries),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go flushLoop(interval(settings, DefaultPushInterval), func() {
+		if err := reporter.push(); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "InfluxDB push error: %v\n", err)
+		}
+	}, reporter.stop, reporter.done)
+	return reporter, nil
+}
+
+// Count implements Reporter interface
+func (reporter *influxReporter) Count(name string, value int64, tags ...Tag) {
+	reporter.mu.Lock()
+	defer reporter.mu.Unlock()
+	reporter.get(name, influxCounter, tags).count += value
+}
+
+// Gauge implements Reporter interface
+func (reporter *influxReporter) Gauge(name string, value float64, tags ...Tag) {
+	reporter.mu.Lock()
+	defer reporter.mu.Unlock()
+	reporter.get(name, influxGauge, tags).value = value
+}
+
+// Timing implements Reporter interface
+func (reporter *influxReporter) Timing(name string, value time.Duration, tags ...Tag) {
+	v := value.Seconds()
+
+	reporter.mu.Lock()
+	defer reporter.mu.Unlock()
+	series := reporter.get(name, influxTiming, tags)
+	if series.count == 0 || v < series.min {
+		series.min = v
+	}
+	if series.count == 0 || v > series.max {
+		series.max = v
+	}
+	series.count++
+	series.sum += v
+}
+
+// Close implements Reporter interface
+func (reporter *influxReporter) Close(ctx context.Context) error {
+	close(reporter.stop)
+	select {
+	case <-reporter.done:
+		return nil
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "failed to push final metrics to InfluxDB")
+	}
+}
+
+// get series, mu must be locked
+func (reporter *influxReporter) get(name string, kind influxKind, tags []Tag) *influxSeries {
+	tagString := influxTags(append(reporter.tags[:len(reporter.tags):len(reporter.tags)], tags...))
+	measurement := influxMeasurementEscaper.Replace(promNS + "_" + name)
+	key := measurement + tagString
+
+	series, ok := reporter.series[key]
+	if !ok {
+		series = &influxSeries{measurement: measurement, tags: tagString, kind: kind}
+		reporter.series[key] = series
+		reporter.order = append(reporter.order, key)
+	}
+	return series
+}
+
+// lines writes current state of all series in line protocol and resets timings
+func (reporter *influxReporter) lines(now time.Time) []byte {
+	reporter.mu.Lock()
+	defer reporter.mu.Unlock()
+
+	var buf bytes.Buffer
+	timestamp := strconv.FormatInt(now.UnixNano(), 10)
+	for _, key := range reporter.order {
+		series := reporter.series[key]
+		var fields string
+		switch series.kind {
+		case influxCounter:
+			fields = "value=" + strconv.FormatInt(series.count, 10) + "i"
+		case influxGauge:
+			fields = "value=" + formatInfluxFloat(series.value)
+		case influxTiming:
+			if series.count < 1 {
+				continue
+			}
+			fields = fmt.Sprintf("count=%di,sum=%s,min=%s,max=%s,mean=%s", series.count, formatInfluxFloat(series.sum),
+				formatInfluxFloat(series.min), formatInfluxFloat(series.max), formatInfluxFloat(series.sum/float64(series.count)))
+			series.count, series.sum, series.min, series.max = 0, 0, 0, 0
+		}
+		buf.WriteString(series.measurement)
+		buf.WriteString(series.tags)
+		buf.WriteByte(' ')
+		buf.WriteString(fields)
+		buf.WriteByte(' ')
+		buf.WriteString(timestamp)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()
+}
+
+func (reporter *influxReporter) push() error {
+	body := reporter.lines(time.Now())
+	if len(body) < 1 {
+		return nil
+	}
+
+	req, err := http.NewRequest(http.MethodPost, reporter.target, bytes.NewReader(body))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	if reporter.token != "" {
+		req.Header.Set("Authorization", "Token "+reporter.token)
+	}
+
+	resp, err := reporter.client.Do(req)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return errors.Errorf("unexpected response<%s>: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// influxTags formats tags sorted on key as a line protocol tag set
+func influxTags(tags []Tag) string {
+	if len(tags) < 1 {
+		return ""
+	}
+	sorted := make([]Tag, len(tags))
+	copy(sorted, tags)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Key < sorted[j].Key
+	})
+
+	var buf strings.Builder
+	for _, tag := range sorted {
+		if tag.Value == "" {
+			continue // empty tag values are not allowed in line protocol
+		}
+		buf.WriteByte(',')
+		buf.WriteString(influxTagEscaper.Replace(tag.Key))
+		buf.WriteByte('=')
+		buf.WriteString(influxTagEscaper.Replace(tag.Value))
+	}
+	return buf.String()
+}
+
+func formatInfluxFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
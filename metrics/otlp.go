@@ -0,0 +1,146 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/gopherciser/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+type (
+	// otlpReporter reports metrics using OTLP over HTTP
+	otlpReporter struct {
+		provider *sdkmetric.MeterProvider
+		meter    metric.Meter
+		tags     []Tag
+
+		mu         sync.Mutex
+		counters   map[string]metric.Int64Counter
+		gauges     map[string]metric.Float64Gauge
+		histograms map[string]metric.Float64Histogram
+	}
+)
+
+const otlpInstrumentationName = "github.com/qlik-oss/gopherciser/metrics"
+
+// otlpBuckets histogram buckets in seconds, same as used for Prometheus action latency
+var otlpBuckets = []float64{0.01, 0.05, 0.1, 0.5, 1, 2, 4, 6}
+
+func newOTLPReporter(ctx context.Context, settings ReporterSettings) (*otlpReporter, error) {
+	var opts []otlpmetrichttp.Option
+	if strings.Contains(settings.Target, "://") {
+		opts = append(opts, otlpmetrichttp.WithEndpointURL(settings.Target))
+	} else {
+		opts = append(opts, otlpmetrichttp.WithEndpoint(settings.Target))
+	}
+
+	exporter, err := otlpmetrichttp.New(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create OTLP metric exporter")
+	}
+
+	serviceName := settings.ServiceName
+	if serviceName == "" {
+		serviceName = promNS
+	}
+	res := resource.NewSchemaless(
+		attribute.String("service.name", serviceName),
+		attribute.String("service.version", version.Version),
+	)
+
+	provider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter, sdkmetric.WithInterval(interval(settings, DefaultPushInterval)))),
+		sdkmetric.WithResource(res),
+	)
+
+	return &otlpReporter{
+		provider:   provider,
+		meter:      provider.Meter(otlpInstrumentationName, metric.WithInstrumentationVersion(version.Version)),
+		tags:       settings.Tags,
+		counters:   make(map[string]metric.Int64Counter),
+		gauges:     make(map[string]metric.Float64Gauge),
+		histograms: make(map[string]metric.Float64Histogram),
+	}, nil
+}
+
+// Count implements Reporter interface
+func (reporter *otlpReporter) Count(name string, value int64, tags ...Tag) {
+	reporter.mu.Lock()
+	counter, ok := reporter.counters[name]
+	if !ok {
+		var err error
+		if counter, err = reporter.meter.Int64Counter(otlpName(name)); err != nil {
+			reporter.mu.Unlock()
+			return
+		}
+		reporter.counters[name] = counter
+	}
+	reporter.mu.Unlock()
+
+	counter.Add(context.Background(), value, metric.WithAttributes(reporter.attributes(tags)...))
+}
+
+// Gauge implements Reporter interface
+func (reporter *otlpReporter) Gauge(name string, value float64, tags ...Tag) {
+	reporter.mu.Lock()
+	gauge, ok := reporter.gauges[name]
+	if !ok {
+		var err error
+		if gauge, err = reporter.meter.Float64Gauge(otlpName(name)); err != nil {
+			reporter.mu.Unlock()
+			return
+		}
+		reporter.gauges[name] = gauge
+	}
+	reporter.mu.Unlock()
+
+	gauge.Record(context.Background(), value, metric.WithAttributes(reporter.attributes(tags)...))
+}
+
+// Timing implements Reporter interface
+func (reporter *otlpReporter) Timing(name string, value time.Duration, tags ...Tag) {
+	reporter.mu.Lock()
+	histogram, ok := reporter.histograms[name]
+	if !ok {
+		var err error
+		if histogram, err = reporter.meter.Float64Histogram(otlpName(name),
+			metric.WithUnit("s"),
+			metric.WithExplicitBucketBoundaries(otlpBuckets...),
+		); err != nil {
+			reporter.mu.Unlock()
+			return
+		}
+		reporter.histograms[name] = histogram
+	}
+	reporter.mu.Unlock()
+
+	histogram.Record(context.Background(), value.Seconds(), metric.WithAttributes(reporter.attributes(tags)...))
+}
+
+// Close implements Reporter interface
+func (reporter *otlpReporter) Close(ctx context.Context) error {
+	return errors.Wrap(reporter.provider.Shutdown(ctx), "failed to flush OTLP metrics")
+}
+
+func (reporter *otlpReporter) attributes(tags []Tag) []attribute.KeyValue {
+	attributes := make([]attribute.KeyValue, 0, len(reporter.tags)+len(tags))
+	for _, tag := range reporter.tags {
+		attributes = append(attributes, attribute.String(tag.Key, tag.Value))
+	}
+	for _, tag := range tags {
+		attributes = append(attributes, attribute.String(tag.Key, tag.Value))
+	}
+	return attributes
+}
+
+func otlpName(name string) string {
+	return promNS + "." + name
+}
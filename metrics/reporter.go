@@ -0,0 +1,133 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// Tag key value pair added to a reported metric
+	Tag struct {
+		Key   string
+		Value string
+	}
+
+	// Reporter reports metrics to a push based backend
+	Reporter interface {
+		// Count adds value to counter name
+		Count(name string, value int64, tags ...Tag)
+		// Gauge sets current value of gauge name
+		Gauge(name string, value float64, tags ...Tag)
+		// Timing records a duration observation
+		Timing(name string, value time.Duration, tags ...Tag)
+		// Close flushes remaining metrics and stops reporter
+		Close(ctx context.Context) error
+	}
+
+	// ReporterType type of push based metrics backend
+	ReporterType int
+
+	// ReporterSettings settings for reporting metrics to a StatsD, InfluxDB or OTLP backend
+	ReporterSettings struct {
+		// Type of backend
+		Type ReporterType
+		// Target of backend. host:port for StatsD, URL of write endpoint for InfluxDB and host:port or URL for OTLP.
+		Target string
+		// Token used to authenticate against InfluxDB
+		Token string
+		// ServiceName reported as service.name for OTLP
+		ServiceName string
+		// Interval between flushes of metrics, defaults to DefaultStatsDInterval for StatsD and
+		// DefaultPushInterval for InfluxDB and OTLP
+		Interval time.Duration
+		// Tags added to all reported metrics
+		Tags []Tag
+	}
+)
+
+// Reporter types
+const (
+	ReporterStatsD ReporterType = iota
+	ReporterDogStatsD
+	ReporterInflux
+	ReporterOTLP
+)
+
+// Names of metrics reported by Reporter
+const (
+	MetricActions               = "actions_total"
+	MetricWarnings              = "warnings_total"
+	MetricErrors                = "errors_per_action"
+	MetricUsers                 = "users_total"
+	MetricActiveUsers           = "active_users"
+	MetricResponseTime          = "response_time"
+	MetricAPIRequestDuration    = "api_request_duration"
+	MetricEngineRequestDuration = "engine_request_duration"
+)
+
+const (
+	// DefaultStatsDInterval default interval between StatsD packets
+	DefaultStatsDInterval = time.Second
+	// DefaultPushInterval default interval between pushes to InfluxDB and OTLP
+	DefaultPushInterval = 10 * time.Second
+)
+
+// NewReporter creates a new reporter of type defined in settings
+func NewReporter(ctx context.Context, settings ReporterSettings) (Reporter, error) {
+	if settings.Target == "" {
+		return nil, errors.New("metrics target not defined")
+	}
+	if settings.Interval < 0 {
+		return nil, errors.Errorf("metrics interval<%v> is negative", settings.Interval)
+	}
+
+	switch settings.Type {
+	case ReporterStatsD, ReporterDogStatsD:
+		return newStatsDReporter(settings)
+	case ReporterInflux:
+		return newInfluxReporter(settings)
+	case ReporterOTLP:
+		return newOTLPReporter(ctx, settings)
+	default:
+		return nil, errors.Errorf("unknown metrics reporter type<%d>", settings.Type)
+	}
+}
+
+// ParseTags parses tags defined in key=value form
+func ParseTags(keyValues []string) ([]Tag, error) {
+	tags := make([]Tag, 0, len(keyValues))
+	for _, kv := range keyValues {
+		key, value, found := strings.Cut(kv, "=")
+		if !found || key == "" {
+			return nil, errors.Errorf("can't parse grouping key %q: must be in 'key=value' form", kv)
+		}
+		tags = append(tags, Tag{Key: key, Value: value})
+	}
+	return tags, nil
+}
+
+func interval(settings ReporterSettings, defaultInterval time.Duration) time.Duration {
+	if settings.Interval > 0 {
+		return settings.Interval
+	}
+	return defaultInterval
+}
+
+// flushLoop executes flush every interval until stop is closed, then flushes a final time and closes done
+func flushLoop(interval time.Duration, flush func(), stop <-chan struct{}, done chan<- struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	defer close(done)
+	for {
+		select {
+		case <-ticker.C:
+			flush()
+		case <-stop:
+			flush()
+			return
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package metrics
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	collectormetrics "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseTags(t *testing.T) {
+	tags, err := ParseTags([]string{"env=test", "region=eu=1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != 2 || tags[0] != (Tag{"env", "test"}) || tags[1] != (Tag{"region", "eu=1"}) {
+		t.Errorf("unexpected tags<%v>", tags)
+	}
+
+	for _, invalid := range []string{"env", "=test"} {
+		if _, err := ParseTags([]string{invalid}); err == nil {
+			t.Errorf("expected error parsing<%s>", invalid)
+		}
+	}
+}
+
+func TestStatsDReporter(t *testing.T) {
+	tests := []struct {
+		typ      ReporterType
+		expected []string
+	}{
+		{ReporterStatsD, []string{
+			"gopherciser.actions_total.test.success.openapp:1|c",
+			"gopherciser.active_users.test:2|g",
+			"gopherciser.response_time.test.open_app:1500.000|ms",
+		}},
+		{ReporterDogStatsD, []string{
+			"gopherciser.actions_total:1|c|#env:test,result:success,action:openapp",
+			"gopherciser.active_users:2|g|#env:test",
+			"gopherciser.response_time:1500.000|ms|#env:test,action:open_app",
+		}},
+	}
+
+	for _, test := range tests {
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		reporter, err := NewReporter(context.Background(), ReporterSettings{
+			Type:   test.typ,
+			Target: conn.LocalAddr().String(),
+			Tags:   []Tag{{"env", "test"}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		reporter.Count(MetricActions, 1, Tag{"result", "success"}, Tag{"action", "openapp"})
+		reporter.Gauge(MetricActiveUsers, 2)
+		reporter.Timing(MetricResponseTime, 1500*time.Millisecond, Tag{"action", "open app"})
+		if err := reporter.Close(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+
+		buf := make([]byte, maxStatsDPacket)
+		_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = conn.Close()
+
+		if expected := strings.Join(test.expected, "\n"); string(buf[:n]) != expected {
+			t.Errorf("unexpected packet:\n%s\nexpected:\n%s", buf[:n], expected)
+		}
+	}
+}
+
+func TestInfluxReporter(t *testing.T) {
+	var mu sync.Mutex
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Token secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		bodies = append(bodies, string(body))
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	reporter, err := NewReporter(context.Background(), ReporterSettings{
+		Type:     ReporterInflux,
+		Target:   server.URL + "/api/v2/write?bucket=test",
+		Token:    "secret",
+		Interval: time.Hour,
+		Tags:     []Tag{{"env", "test"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	reporter.Count(MetricActions, 1, Tag{"result", "success"}, Tag{"action", "open app"})
+	reporter.Count(MetricActions, 2, Tag{"result", "success"}, Tag{"action", "open app"})
+	reporter.Gauge(MetricActiveUsers, 3)
+	reporter.Timing(MetricResponseTime, time.Second, Tag{"action", "openapp"})
+	reporter.Timing(MetricResponseTime, 3*time.Second, Tag{"action", "openapp"})
+	if err := reporter.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(bodies) != 1 {
+		t.Fatalf("expected 1 push, got<%d>", len(bodies))
+	}
+	lines := strings.Split(strings.TrimSpace(bodies[0]), "\n")
+	expected := []string{
+		`gopherciser_actions_total,action=open\ app,env=test,result=success value=3i`,
+		`gopherciser_active_users,env=test value=3`,
+		`gopherciser_response_time,action=openapp,env=test count=2i,sum=4,min=1,max=3,mean=2`,
+	}
+	if len(lines) != len(expected) {
+		t.Fatalf("unexpected lines:\n%s", bodies[0])
+	}
+	for i, line := range lines {
+		if !strings.HasPrefix(line, expected[i]+" ") {
+			t.Errorf("unexpected line<%s> expected<%s>", line, expected[i])
+		}
+	}
+}
+
+func TestOTLPReporter(t *testing.T) {
+	var mu sync.Mutex
+	names := make(map[string]bool)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		var req collectormetrics.ExportMetricsServiceRequest
+		if err := proto.Unmarshal(raw, &req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		for _, resourceMetrics := range req.GetResourceMetrics() {
+			for _, scopeMetrics := range resourceMetrics.GetScopeMetrics() {
+				for _, m := range scopeMetrics.GetMetrics() {
+					names[m.GetName()] = true
+				}
+			}
+		}
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		body, _ := proto.Marshal(&collectormetrics.ExportMetricsServiceResponse{})
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	reporter, err := NewReporter(context.Background(), ReporterSettings{
+		Type:     ReporterOTLP,
+		Target:   server.URL,
+		Interval: time.Hour,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	reporter.Count(MetricActions, 1, Tag{"result", "success"}, Tag{"action", "openapp"})
+	reporter.Gauge(MetricActiveUsers, 1)
+	reporter.Timing(MetricResponseTime, time.Second, Tag{"action", "openapp"})
+	if err := reporter.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, name := range []string{"gopherciser.actions_total", "gopherciser.active_users", "gopherciser.response_time"} {
+		if !names[name] {
+			t.Errorf("metric<%s> not exported, got<%v>", name, names)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// statsDReporter reports metrics as StatsD lines over UDP. Tags are added using the DogStatsD extension when
+	// tagged is set, otherwise tag values are added to the metric name.
+	statsDReporter struct {
+		conn   net.Conn
+		tagged bool
+		tags   []Tag
+
+		mu  sync.Mutex
+		buf bytes.Buffer
+
+		stop chan struct{}
+		done chan struct{}
+	}
+)
+
+// maxStatsDPacket keeps packets within a common network MTU
+const maxStatsDPacket = 1432
+
+var statsDReplacer = strings.NewReplacer(":", "_", "|", "_", "@", "_", "#", "_", ",", "_", " ", "_", "\n", "_")
+
+func newStatsDReporter(settings ReporterSettings) (*statsDReporter, error) {
+	conn, err := net.Dial("udp", settings.Target)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to StatsD target<%s>", settings.Target)
+	}
+
+	reporter := &statsDReporter{
+		conn:   conn,
+		tagged: settings.Type == ReporterDogStatsD,
+		tags:   settings.Tags,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go flushLoop(interval(settings, DefaultStatsDInterval), reporter.flush, reporter.stop, reporter.done)
+	return reporter, nil
+}
+
+// Count implements Reporter interface
+func (reporter *statsDReporter) Count(name string, value int64, tags ...Tag) {
+	reporter.add(name, strconv.FormatInt(value, 10), "c", tags)
+}
+
+// Gauge implements Reporter interface
+func (reporter *statsDReporter) Gauge(name string, value float64, tags ...Tag) {
+	reporter.add(name, strconv.FormatFloat(value, 'f', -1, 64), "g", tags)
+}
+
+// Timing implements Reporter interface
+func (reporter *statsDReporter) Timing(name string, value time.Duration, tags ...Tag) {
+	reporter.add(name, strconv.FormatFloat(float64(value)/float64(time.Millisecond), 'f', 3, 64), "ms", tags)
+}
+
+// Close implements Reporter interface
+func (reporter *statsDReporter) Close(ctx context.Context) error {
+	close(reporter.stop)
+	select {
+	case <-reporter.done:
+	case <-ctx.Done():
+	}
+	return errors.WithStack(reporter.conn.Close())
+}
+
+func (reporter *statsDReporter) add(name, value, typ string, tags []Tag) {
+	line := reporter.line(name, value, typ, tags)
+
+	reporter.mu.Lock()
+	defer reporter.mu.Unlock()
+	if reporter.buf.Len() > 0 && reporter.buf.Len()+len(line)+1 > maxStatsDPacket {
+		reporter.send()
+	}
+	if reporter.buf.Len() > 0 {
+		reporter.buf.WriteByte('\n')
+	}
+	reporter.buf.WriteString(line)
+}
+
+func (reporter *statsDReporter) line(name, value, typ string, tags []Tag) string {
+	var line strings.Builder
+	line.WriteString(promNS)
+	line.WriteByte('.')
+	line.WriteString(statsDReplacer.Replace(name))
+
+	if !reporter.tagged {
+		for _, tag := range append(reporter.tags[:len(reporter.tags):len(reporter.tags)], tags...) {
+			line.WriteByte('.')
+			line.WriteString(strings.ReplaceAll(statsDReplacer.Replace(tag.Value), ".", "_"))
+		}
+	}
+
+	line.WriteByte(':')
+	line.WriteString(value)
+	line.WriteByte('|')
+	line.WriteString(typ)
+
+	if reporter.tagged && len(reporter.tags)+len(tags) > 0 {
+		line.WriteString("|#")
+		for i, tag := range append(reporter.tags[:len(reporter.tags):len(reporter.tags)], tags...) {
+			if i > 0 {
+				line.WriteByte(',')
+			}
+			line.WriteString(statsDReplacer.Replace(tag.Key))
+			line.WriteByte(':')
+			line.WriteString(statsDReplacer.Replace(tag.Value))
+		}
+	}
+
+	return line.String()
+}
+
+func (reporter *statsDReporter) flush() {
+	reporter.mu.Lock()
+	defer reporter.mu.Unlock()
+	reporter.send()
+}
+
+// send current buffer as one packet, mu must be locked
+func (reporter *statsDReporter) send() {
+	if reporter.buf.Len() < 1 {
+		return
+	}
+	if _, err := reporter.conn.Write(reporter.buf.Bytes()); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "StatsD send error: %v\n", err)
+	}
+	reporter.buf.Reset()
+}
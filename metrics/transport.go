@@ -40,6 +40,7 @@ func setupMetrics(actions []string, apimetrics bool) error {
 	prometheus.MustRegister(GopherActiveUsers)
 	prometheus.MustRegister(GopherResponseTimes)
 	prometheus.MustRegister(GopherActionLatencyHist)
+	prometheus.MustRegister(GopherEngineRequestDuration)
 	prometheus.MustRegister(BuildInfo)
 
 	err := gopherRegistry.Register(collectors.NewProcessCollector(collectors.ProcessCollectorOpts{
@@ -82,6 +83,10 @@ func setupMetrics(actions []string, apimetrics bool) error {
 	if err != nil {
 		return err
 	}
+	err = gopherRegistry.Register(GopherEngineRequestDuration)
+	if err != nil {
+		return err
+	}
 	err = gopherRegistry.Register(BuildInfo)
 	if err != nil {
 		return err
@@ -150,6 +150,26 @@ const (
 	ActionGetScript             = "getscript"
 	ActionChangeSteam           = "changestream"
 	ActionStepDimension         = "stepdimension"
+	ActionAssert                = "assert"
+	ActionScroll                = "scroll"
+	ActionPivot                 = "pivot"
+	ActionDrillDown             = "drilldown"
+	ActionBack                  = "back"
+	ActionForward               = "forward"
+	ActionLockAll               = "lockall"
+	ActionUnlockAll             = "unlockall"
+	ActionLockField             = "lockfield"
+	ActionUnlockField           = "unlockfield"
+	ActionVariableInput         = "variableinput"
+	ActionDatePicker            = "datepicker"
+	ActionRestRequest           = "restrequest"
+	ActionExportData            = "exportdata"
+	ActionCreateApp             = "createapp"
+	ActionDuplicateApp          = "duplicateapp"
+	ActionImportApp             = "importapp"
+	ActionExportApp             = "exportapp"
+	ActionDeleteApp             = "deleteapp"
+	ActionOpenSessionApp        = "opensessionapp"
 )
 
 // Scenario actions needs an entry in actionHandler
@@ -263,6 +283,26 @@ func ResetDefaultActions() {
 		ActionGetScript:             GetscriptSettings{},
 		ActionChangeSteam:           ChangestreamSettings{},
 		ActionStepDimension:         StepDimensionSettings{},
+		ActionAssert:                AssertSettings{},
+		ActionScroll:                ScrollSettings{},
+		ActionPivot:                 PivotSettings{},
+		ActionDrillDown:             DrillDownSettings{},
+		ActionBack:                  BackSettings{},
+		ActionForward:               ForwardSettings{},
+		ActionLockAll:               LockAllSettings{},
+		ActionUnlockAll:             UnlockAllSettings{},
+		ActionLockField:             LockFieldSettings{},
+		ActionUnlockField:           UnlockFieldSettings{},
+		ActionVariableInput:         VariableInputSettings{},
+		ActionDatePicker:            DatePickerSettings{},
+		ActionRestRequest:           RestRequestSettings{},
+		ActionExportData:            ExportDataSettings{},
+		ActionCreateApp:             CreateAppSettings{},
+		ActionDuplicateApp:          DuplicateAppSettings{},
+		ActionImportApp:             ImportAppSettings{},
+		ActionExportApp:             ExportAppSettings{},
+		ActionDeleteApp:             DeleteAppSettings{},
+		ActionOpenSessionApp:        OpenSessionAppSettings{},
 	}
 }
 
@@ -379,6 +419,7 @@ func (act *Action) execute(sessionState *session.State, connectionSettings *conn
 		if sessionState != nil && sessionState.LogEntry != nil {
 			sessionState.LogEntry.LogError(panicErr)
 		}
+		sessionState.Trace.EndAction(false, panicErr)
 		return false, errors.WithStack(panicErr)
 	}
 
@@ -419,6 +460,7 @@ func (act *Action) startAction(sessionState *session.State) *logger.ActionEntry
 		Label:    act.Label,
 	}
 	act.setActionStart(sessionState, actionEntry, "START")
+	sessionState.Trace.StartAction(actionEntry.Action, actionEntry.Label, actionEntry.ActionID)
 	return actionEntry
 }
 
@@ -444,6 +486,7 @@ func (act *Action) endAction(sessionState *session.State, actionState *action.St
 
 	var errs *multierror.Error
 	errs = multierror.Append(errs, logResult(sessionState, actionState, actionState.Details, containerActionEntry))
+	sessionState.Trace.EndAction(!actionState.Failed, actionState.Errors())
 	sessionState.LogEntry.LogDebugf("%s END", act.Type)
 	errs = multierror.Append(errs, logObjectRegressionData(sessionState))
 
@@ -15,7 +15,8 @@ type (
 	//ApplyBookmarkSettings apply bookmark settings
 	ApplyBookmarkSettings struct {
 		BookMarkSettings
-		SelectionsOnly bool `json:"selectionsonly" displayname:"Apply selections only" doc-key:"applybookmark.selectionsonly"`
+		SelectionsOnly bool   `json:"selectionsonly" displayname:"Apply selections only" doc-key:"applybookmark.selectionsonly"`
+		State          string `json:"state,omitempty" displayname:"Alternate state" doc-key:"applybookmark.state"`
 	}
 
 	bmSearchTerm int
@@ -55,6 +56,11 @@ func (settings ApplyBookmarkSettings) Execute(sessionState *session.State, actio
 		return
 	}
 
+	if err := trackStateSelections(sessionState, actionState, settings.State); err != nil {
+		actionState.AddErrors(errors.WithStack(err))
+		return
+	}
+
 	err = sessionState.SendRequest(actionState, func(ctx context.Context) error {
 		success, err := uplink.CurrentApp.Doc.ApplyBookmark(ctx, id)
 		if err != nil {
@@ -0,0 +1,428 @@
+package scenario
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/enigma-go/v4"
+	"github.com/qlik-oss/gopherciser/action"
+	"github.com/qlik-oss/gopherciser/connection"
+	"github.com/qlik-oss/gopherciser/enigmahandlers"
+	"github.com/qlik-oss/gopherciser/enummap"
+	"github.com/qlik-oss/gopherciser/globals/constant"
+	"github.com/qlik-oss/gopherciser/logger"
+	"github.com/qlik-oss/gopherciser/session"
+)
+
+type (
+	// AssertionType type of assertion
+	AssertionType int
+	// AssertionOperator operator used to compare object data with expected value
+	AssertionOperator int
+
+	// Assertion expectation on object data
+	Assertion struct {
+		// Type of assertion
+		Type AssertionType `json:"type" displayname:"Assertion type" doc-key:"assert.assertions.type"`
+		// Operator used to compare with Value
+		Operator AssertionOperator `json:"operator,omitempty" displayname:"Operator" doc-key:"assert.assertions.operator"`
+		// Value expected value
+		Value string `json:"value,omitempty" displayname:"Value" doc-key:"assert.assertions.value"`
+		// Row of cell for value assertion
+		Row int `json:"row,omitempty" displayname:"Row" doc-key:"assert.assertions.row"`
+		// Column of cell for value assertion
+		Column int `json:"column,omitempty" displayname:"Column" doc-key:"assert.assertions.column"`
+	}
+
+	// AssertSettings evaluate assertions on latest data of an object
+	AssertSettings struct {
+		ID         string      `json:"id" displayname:"Object ID" doc-key:"assert.id"`
+		Assertions []Assertion `json:"assertions" displayname:"Assertions" doc-key:"assert.assertions"`
+	}
+
+	// AssertionFailedError assertion on object data not fulfilled
+	AssertionFailedError struct {
+		ID        string
+		Assertion string
+		Reason    string
+	}
+)
+
+// AssertionType enum
+const (
+	AssertionRowCount AssertionType = iota
+	AssertionValue
+	AssertionNoCalcConditionError
+	AssertionNoError
+)
+
+// AssertionOperator enum
+const (
+	AssertionOperatorEquals AssertionOperator = iota
+	AssertionOperatorNotEquals
+	AssertionOperatorGreater
+	AssertionOperatorGreaterOrEqual
+	AssertionOperatorLess
+	AssertionOperatorLessOrEqual
+	AssertionOperatorContains
+)
+
+var (
+	assertionTypeEnumMap = enummap.NewEnumMapOrPanic(map[string]int{
+		"rowcount":             int(AssertionRowCount),
+		"value":                int(AssertionValue),
+		"nocalcconditionerror": int(AssertionNoCalcConditionError),
+		"noerror":              int(AssertionNoError),
+	})
+
+	assertionOperatorEnumMap = enummap.NewEnumMapOrPanic(map[string]int{
+		"eq":       int(AssertionOperatorEquals),
+		"ne":       int(AssertionOperatorNotEquals),
+		"gt":       int(AssertionOperatorGreater),
+		"gte":      int(AssertionOperatorGreaterOrEqual),
+		"lt":       int(AssertionOperatorLess),
+		"lte":      int(AssertionOperatorLessOrEqual),
+		"contains": int(AssertionOperatorContains),
+	})
+)
+
+// GetEnumMap returns assertion type enum map to GUI
+func (value AssertionType) GetEnumMap() *enummap.EnumMap {
+	return assertionTypeEnumMap
+}
+
+// UnmarshalJSON unmarshal AssertionType
+func (value *AssertionType) UnmarshalJSON(arg []byte) error {
+	i, err := value.GetEnumMap().UnMarshal(arg)
+	if err != nil {
+		return errors.Wrap(err, "Failed to unmarshal AssertionType")
+	}
+
+	*value = AssertionType(i)
+	return nil
+}
+
+// MarshalJSON marshal AssertionType
+func (value AssertionType) MarshalJSON() ([]byte, error) {
+	str, err := value.GetEnumMap().String(int(value))
+	if err != nil {
+		return nil, errors.Errorf("Unknown AssertionType<%d>", value)
+	}
+	return []byte(fmt.Sprintf(`"%s"`, str)), nil
+}
+
+// String representation of AssertionType
+func (value AssertionType) String() string {
+	return value.GetEnumMap().StringDefault(int(value), strconv.Itoa(int(value)))
+}
+
+// GetEnumMap returns assertion operator enum map to GUI
+func (value AssertionOperator) GetEnumMap() *enummap.EnumMap {
+	return assertionOperatorEnumMap
+}
+
+// UnmarshalJSON unmarshal AssertionOperator
+func (value *AssertionOperator) UnmarshalJSON(arg []byte) error {
+	i, err := value.GetEnumMap().UnMarshal(arg)
+	if err != nil {
+		return errors.Wrap(err, "Failed to unmarshal AssertionOperator")
+	}
+
+	*value = AssertionOperator(i)
+	return nil
+}
+
+// MarshalJSON marshal AssertionOperator
+func (value AssertionOperator) MarshalJSON() ([]byte, error) {
+	str, err := value.GetEnumMap().String(int(value))
+	if err != nil {
+		return nil, errors.Errorf("Unknown AssertionOperator<%d>", value)
+	}
+	return []byte(fmt.Sprintf(`"%s"`, str)), nil
+}
+
+// String representation of AssertionOperator
+func (value AssertionOperator) String() string {
+	return value.GetEnumMap().StringDefault(int(value), strconv.Itoa(int(value)))
+}
+
+// isNumeric returns true if operator only compares numbers
+func (value AssertionOperator) isNumeric() bool {
+	switch value {
+	case AssertionOperatorGreater, AssertionOperatorGreaterOrEqual, AssertionOperatorLess, AssertionOperatorLessOrEqual:
+		return true
+	default:
+		return false
+	}
+}
+
+// compareNumber compares actual with expected using operator
+func (value AssertionOperator) compareNumber(actual, expected float64) bool {
+	switch value {
+	case AssertionOperatorEquals:
+		return actual == expected
+	case AssertionOperatorNotEquals:
+		return actual != expected
+	case AssertionOperatorGreater:
+		return actual > expected
+	case AssertionOperatorGreaterOrEqual:
+		return actual >= expected
+	case AssertionOperatorLess:
+		return actual < expected
+	case AssertionOperatorLessOrEqual:
+		return actual <= expected
+	default:
+		return false
+	}
+}
+
+// compareText compares actual with expected using operator
+func (value AssertionOperator) compareText(actual, expected string) bool {
+	switch value {
+	case AssertionOperatorEquals:
+		return actual == expected
+	case AssertionOperatorNotEquals:
+		return actual != expected
+	case AssertionOperatorContains:
+		return strings.Contains(actual, expected)
+	default:
+		return false
+	}
+}
+
+// Error implements error interface
+func (err AssertionFailedError) Error() string {
+	return fmt.Sprintf("object<%s> assertion<%s> failed: %s", err.ID, err.Assertion, err.Reason)
+}
+
+// ErrorCategory implements logger.ErrorCategorizer interface
+func (err AssertionFailedError) ErrorCategory() logger.ErrorCategory {
+	return logger.ErrorCategoryValidation
+}
+
+// String representation of assertion, used in error messages
+func (assertion Assertion) String() string {
+	switch assertion.Type {
+	case AssertionRowCount:
+		return fmt.Sprintf("rowcount %s %s", assertion.Operator, assertion.Value)
+	case AssertionValue:
+		return fmt.Sprintf("value[%d][%d] %s %s", assertion.Row, assertion.Column, assertion.Operator, assertion.Value)
+	default:
+		return assertion.Type.String()
+	}
+}
+
+// Validate assertion
+func (assertion Assertion) Validate() error {
+	if _, err := assertionTypeEnumMap.String(int(assertion.Type)); err != nil {
+		return errors.Errorf("unknown assertion type<%d>", assertion.Type)
+	}
+	if _, err := assertionOperatorEnumMap.String(int(assertion.Operator)); err != nil {
+		return errors.Errorf("unknown assertion operator<%d>", assertion.Operator)
+	}
+
+	switch assertion.Type {
+	case AssertionRowCount:
+		if assertion.Operator == AssertionOperatorContains {
+			return errors.Errorf("operator<%s> not supported by assertion type<%s>", assertion.Operator, assertion.Type)
+		}
+		if _, err := strconv.Atoi(assertion.Value); err != nil {
+			return errors.Errorf("assertion type<%s> requires integer value, got<%s>", assertion.Type, assertion.Value)
+		}
+	case AssertionValue:
+		if assertion.Row < 0 || assertion.Column < 0 {
+			return errors.Errorf("illegal cell row<%d> column<%d>", assertion.Row, assertion.Column)
+		}
+		if assertion.Operator.isNumeric() {
+			if _, err := strconv.ParseFloat(assertion.Value, 64); err != nil {
+				return errors.Errorf("operator<%s> requires numeric value, got<%s>", assertion.Operator, assertion.Value)
+			}
+		}
+	}
+	return nil
+}
+
+// Validate AssertSettings action (Implements ActionSettings interface)
+func (settings AssertSettings) Validate() ([]string, error) {
+	if settings.ID == "" {
+		return nil, errors.Errorf("no object id defined for %s", ActionAssert)
+	}
+	if len(settings.Assertions) < 1 {
+		return nil, errors.Errorf("no assertions defined for %s", ActionAssert)
+	}
+	for i, assertion := range settings.Assertions {
+		if err := assertion.Validate(); err != nil {
+			return nil, errors.Wrapf(err, "assertion<%d>", i)
+		}
+	}
+	return nil, nil
+}
+
+// Execute AssertSettings action (Implements ActionSettings interface)
+func (settings AssertSettings) Execute(sessionState *session.State, actionState *action.State, connection *connection.ConnectionSettings, label string, reset func()) {
+	if sessionState.Connection == nil || sessionState.Connection.Sense() == nil {
+		actionState.AddErrors(errors.New("Not connected to a Sense environment"))
+		return
+	}
+
+	// make sure pending object updates are done before evaluating data
+	sessionState.Wait(actionState)
+	if actionState.Failed {
+		return
+	}
+
+	uplink := sessionState.Connection.Sense()
+	objectID := sessionState.IDMap.Get(settings.ID)
+	obj, err := uplink.Objects.GetObjectByID(objectID)
+	if err != nil {
+		actionState.AddErrors(errors.Wrapf(err, "Failed getting object<%s> from object list", objectID))
+		return
+	}
+
+	if linkedObjHandle := uplink.Objects.GetObjectLink(obj.Handle); linkedObjHandle != 0 {
+		obj, err = uplink.Objects.GetObject(linkedObjHandle)
+		if err != nil {
+			actionState.AddErrors(errors.Wrapf(err, "Failed getting linked object<%d> object<%s>", linkedObjHandle, objectID))
+			return
+		}
+	}
+
+	for _, assertion := range settings.Assertions {
+		if reason := assertion.evaluate(obj); reason != "" {
+			actionState.AddErrors(errors.WithStack(AssertionFailedError{
+				ID:        objectID,
+				Assertion: assertion.String(),
+				Reason:    reason,
+			}))
+		}
+	}
+}
+
+// evaluate assertion on object data, returns reason of failure or empty string if assertion is fulfilled
+func (assertion Assertion) evaluate(obj *enigmahandlers.Object) string {
+	switch assertion.Type {
+	case AssertionRowCount:
+		rows, err := objectRowCount(obj)
+		if err != nil {
+			return err.Error()
+		}
+		expected, _ := strconv.Atoi(assertion.Value)
+		if !assertion.Operator.compareNumber(float64(rows), float64(expected)) {
+			return fmt.Sprintf("object has %d rows", rows)
+		}
+	case AssertionValue:
+		cell, err := objectCell(obj, assertion.Row, assertion.Column)
+		if err != nil {
+			return err.Error()
+		}
+		if !assertion.compareCell(cell) {
+			return fmt.Sprintf("cell has value<%s>", cell.Text)
+		}
+	case AssertionNoCalcConditionError, AssertionNoError:
+		for _, nve := range objectValidationErrors(obj) {
+			if nve.ErrorCode == constant.LocerrCalcEvalConditionFailed || assertion.Type == AssertionNoError {
+				return fmt.Sprintf("object has error<%s>", session.EngineCodeToString(nve.ErrorCode))
+			}
+		}
+	default:
+		return fmt.Sprintf("unknown assertion type<%d>", assertion.Type)
+	}
+	return ""
+}
+
+// compareCell compares cell with expected value, numeric cells are compared as numbers when value is a number
+func (assertion Assertion) compareCell(cell *enigma.NxCell) bool {
+	if assertion.Operator == AssertionOperatorContains {
+		return assertion.Operator.compareText(cell.Text, assertion.Value)
+	}
+
+	expected, err := strconv.ParseFloat(assertion.Value, 64)
+	num := float64(cell.Num)
+	if err != nil || math.IsNaN(num) {
+		if assertion.Operator.isNumeric() {
+			return false
+		}
+		return assertion.Operator.compareText(cell.Text, assertion.Value)
+	}
+	return assertion.Operator.compareNumber(num, expected)
+}
+
+// objectRowCount returns amount of rows in hypercube or listobject of object
+func objectRowCount(obj *enigmahandlers.Object) (int, error) {
+	if hypercube := obj.HyperCube(); hypercube != nil && hypercube.HyperCube != nil {
+		if hypercube.Size == nil {
+			return 0, errors.New("object has no hypercube size")
+		}
+		return hypercube.Size.Cy, nil
+	}
+	if listobject := obj.ListObject(); listobject != nil {
+		if listobject.Size == nil {
+			return 0, errors.New("object has no listobject size")
+		}
+		return listobject.Size.Cy, nil
+	}
+	return 0, errors.New("object has neither hypercube nor listobject")
+}
+
+// objectCell returns cell at row and column of first data page containing it
+func objectCell(obj *enigmahandlers.Object, row, column int) (*enigma.NxCell, error) {
+	pages := obj.HyperCubeDataPages()
+	if len(pages) < 1 {
+		pages = obj.ListObjectDataPages()
+	}
+	if len(pages) < 1 {
+		return nil, errors.New("object has no data pages")
+	}
+
+	for _, page := range pages {
+		if page == nil {
+			continue
+		}
+		top, left := 0, 0
+		if page.Area != nil {
+			top, left = page.Area.Top, page.Area.Left
+		}
+		r, c := row-top, column-left
+		if r < 0 || r >= len(page.Matrix) || c < 0 || c >= len(page.Matrix[r]) {
+			continue
+		}
+		if cell := page.Matrix[r][c]; cell != nil {
+			return cell, nil
+		}
+	}
+	return nil, errors.Errorf("cell row<%d> column<%d> not in data pages", row, column)
+}
+
+// objectValidationErrors returns errors of hypercube, dimensions and measures of object
+func objectValidationErrors(obj *enigmahandlers.Object) []*enigma.NxValidationError {
+	var nves []*enigma.NxValidationError
+	add := func(nve *enigma.NxValidationError) {
+		if nve != nil {
+			nves = append(nves, nve)
+		}
+	}
+
+	if hypercube := obj.HyperCube(); hypercube != nil && hypercube.HyperCube != nil {
+		add(hypercube.Error)
+		for _, dimInfo := range hypercube.DimensionInfo {
+			if dimInfo != nil {
+				add(dimInfo.Error)
+			}
+		}
+		for _, measureInfo := range hypercube.MeasureInfo {
+			if measureInfo != nil {
+				add(measureInfo.Error)
+			}
+		}
+	}
+	if listobject := obj.ListObject(); listobject != nil {
+		add(listobject.Error)
+		if listobject.DimensionInfo != nil {
+			add(listobject.DimensionInfo.Error)
+		}
+	}
+	return nves
+}
@@ -0,0 +1,94 @@
+package scenario
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/qlik-oss/enigma-go/v4"
+	"github.com/qlik-oss/gopherciser/enigmahandlers"
+	"github.com/qlik-oss/gopherciser/globals/constant"
+)
+
+func TestAssertSettingsUnmarshal(t *testing.T) {
+	raw := `{
+		"id": "kpi1",
+		"assertions": [
+			{ "type": "rowcount", "operator": "gt", "value": "0" },
+			{ "type": "value", "operator": "eq", "value": "1234" },
+			{ "type": "nocalcconditionerror" }
+		]
+	}`
+
+	var settings AssertSettings
+	if err := json.Unmarshal([]byte(raw), &settings); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := settings.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if len(settings.Assertions) != 3 || settings.Assertions[0].Operator != AssertionOperatorGreater ||
+		settings.Assertions[2].Type != AssertionNoCalcConditionError {
+		t.Errorf("unexpected assertions<%+v>", settings.Assertions)
+	}
+
+	invalid := []AssertSettings{
+		{ID: "kpi1"},
+		{Assertions: []Assertion{{Type: AssertionNoError}}},
+		{ID: "kpi1", Assertions: []Assertion{{Type: AssertionRowCount, Value: "many"}}},
+		{ID: "kpi1", Assertions: []Assertion{{Type: AssertionValue, Operator: AssertionOperatorLess, Value: "abc"}}},
+	}
+	for _, settings := range invalid {
+		if _, err := settings.Validate(); err == nil {
+			t.Errorf("expected validation error for<%+v>", settings)
+		}
+	}
+}
+
+func TestAssertionEvaluate(t *testing.T) {
+	obj := enigmahandlers.NewObject(1, enigmahandlers.ObjTypeGenericObject, "table1", nil)
+	obj.SetHyperCube(&enigma.HyperCube{
+		Size: &enigma.Size{Cx: 2, Cy: 3},
+		MeasureInfo: []*enigma.NxMeasureInfo{{
+			Error: &enigma.NxValidationError{ErrorCode: constant.LocerrCalcEvalConditionFailed},
+		}},
+	})
+	if err := obj.SetHyperCubeDataPages([]*enigma.NxDataPage{{
+		Area: &enigma.Rect{Top: 0, Left: 0, Width: 2, Height: 2},
+		Matrix: []enigma.NxCellRows{
+			{{Text: "Sweden", Num: enigma.Float64(math.NaN())}, {Text: "1 234", Num: 1234}},
+			{{Text: "Norway", Num: enigma.Float64(math.NaN())}, {Text: "12", Num: 12}},
+		},
+	}}, false); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		assertion Assertion
+		ok        bool
+	}{
+		{Assertion{Type: AssertionRowCount, Operator: AssertionOperatorGreater, Value: "0"}, true},
+		{Assertion{Type: AssertionRowCount, Operator: AssertionOperatorEquals, Value: "2"}, false},
+		{Assertion{Type: AssertionValue, Column: 1, Operator: AssertionOperatorEquals, Value: "1234"}, true},
+		{Assertion{Type: AssertionValue, Row: 1, Column: 1, Operator: AssertionOperatorGreaterOrEqual, Value: "20"}, false},
+		{Assertion{Type: AssertionValue, Row: 1, Operator: AssertionOperatorEquals, Value: "Norway"}, true},
+		{Assertion{Type: AssertionValue, Operator: AssertionOperatorContains, Value: "wed"}, true},
+		{Assertion{Type: AssertionValue, Operator: AssertionOperatorGreater, Value: "1"}, false},
+		{Assertion{Type: AssertionValue, Row: 5, Operator: AssertionOperatorEquals, Value: "1"}, false},
+		{Assertion{Type: AssertionNoCalcConditionError}, false},
+		{Assertion{Type: AssertionNoError}, false},
+	}
+
+	for _, test := range tests {
+		reason := test.assertion.evaluate(obj)
+		if ok := reason == ""; ok != test.ok {
+			t.Errorf("assertion<%s> expected ok<%v> got reason<%s>", test.assertion, test.ok, reason)
+		}
+	}
+
+	// object without errors
+	obj.SetHyperCube(&enigma.HyperCube{Size: &enigma.Size{Cx: 1, Cy: 1}})
+	if reason := (Assertion{Type: AssertionNoError}).evaluate(obj); reason != "" {
+		t.Errorf("expected no error, got<%s>", reason)
+	}
+}
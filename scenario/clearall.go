@@ -6,12 +6,15 @@ import (
 	"github.com/pkg/errors"
 	"github.com/qlik-oss/gopherciser/action"
 	"github.com/qlik-oss/gopherciser/connection"
+	"github.com/qlik-oss/gopherciser/senseobjects"
 	"github.com/qlik-oss/gopherciser/session"
 )
 
 type (
 	//ClearAllSettings clear all selections action
-	ClearAllSettings struct{}
+	ClearAllSettings struct {
+		State string `json:"state,omitempty" displayname:"Alternate state" doc-key:"clearall.state"`
+	}
 )
 
 // Validate ClearAll action (Implements ActionSettings interface)
@@ -21,32 +24,7 @@ func (settings ClearAllSettings) Validate() ([]string, error) {
 
 // Execute ClearAll action (Implements ActionSettings interface)
 func (settings ClearAllSettings) Execute(sessionState *session.State, actionState *action.State, connection *connection.ConnectionSettings, label string, reset func()) {
-	if sessionState.Connection == nil || sessionState.Connection.Sense() == nil {
-		actionState.AddErrors(errors.New("Not connected to a Sense environment"))
-		return
-	}
-
-	app := sessionState.Connection.Sense().CurrentApp
-	if app == nil {
-		actionState.AddErrors(errors.New("Not connected to a Sense app"))
-		return
-	}
-
-	// Send GetApplayout request, synchronously or otherwise it will be aborted by clearall request (and re-sent)
-	err := sessionState.SendRequest(actionState, func(ctx context.Context) error {
-		_, err := app.Doc.GetAppLayout(ctx)
-		return errors.WithStack(err)
+	executeAppSelectionRequest(sessionState, actionState, settings.State, "Failed to clear all", func(ctx context.Context, app *senseobjects.App) error {
+		return errors.WithStack(app.Doc.ClearAll(ctx, false, settings.State))
 	})
-	if err != nil {
-		actionState.AddErrors(errors.Wrap(err, "GetAppLayout request failed"))
-	}
-
-	sessionState.QueueRequest(func(ctx context.Context) error {
-		if err := app.Doc.ClearAll(ctx, false, ""); err != nil {
-			return errors.WithStack(err)
-		}
-		return nil
-	}, actionState, true, "Failed to clear all")
-
-	sessionState.Wait(actionState)
 }
@@ -12,7 +12,8 @@ import (
 type (
 	// ClearFieldSettings clear all selections in field
 	ClearFieldSettings struct {
-		Name string `json:"name" displayname:"name" doc-key:"clearfield.name"` // TODO add appstructure:"fields:name" when supported by GUI
+		Name  string `json:"name" displayname:"name" doc-key:"clearfield.name"` // TODO add appstructure:"fields:name" when supported by GUI
+		State string `json:"state,omitempty" displayname:"Alternate state" doc-key:"clearfield.state"`
 	}
 )
 
@@ -37,9 +38,14 @@ func (settings ClearFieldSettings) Execute(sessionState *session.State, actionSt
 		return
 	}
 
+	if err := trackStateSelections(sessionState, actionState, settings.State); err != nil {
+		actionState.AddErrors(errors.WithStack(err))
+		return
+	}
+
 	fieldName := settings.Name
 	sessionState.QueueRequest(func(ctx context.Context) error {
-		field, err := app.Doc.GetField(ctx, settings.Name, "")
+		field, err := app.Doc.GetField(ctx, settings.Name, settings.State)
 		if err != nil {
 			return errors.Wrapf(err, "error getting field<%s>", fieldName)
 		}
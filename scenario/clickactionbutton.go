@@ -228,10 +228,10 @@ func (buttonAction *buttonAction) execute(sessionState *session.State, actionSta
 	case applyBookmark:
 		subActionType = ActionApplyBookmark
 		subActionSettings = &ApplyBookmarkSettings{
-			BookMarkSettings{
+			BookMarkSettings: BookMarkSettings{
 				ID: buttonAction.Bookmark,
 			},
-			true,
+			SelectionsOnly: true,
 		}
 
 	case clearAllSelections:
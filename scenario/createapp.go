@@ -0,0 +1,61 @@
+package scenario
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/gopherciser/action"
+	"github.com/qlik-oss/gopherciser/connection"
+	"github.com/qlik-oss/gopherciser/session"
+	"github.com/qlik-oss/gopherciser/synced"
+)
+
+type (
+	// CreateAppSettings create new empty app
+	CreateAppSettings struct {
+		Name synced.Template `json:"name" displayname:"App name" doc-key:"createapp.name"`
+	}
+)
+
+// Validate CreateApp action (Implements ActionSettings interface)
+func (settings CreateAppSettings) Validate() ([]string, error) {
+	if settings.Name.String() == "" {
+		return nil, errors.Errorf("no app name defined for %s", ActionCreateApp)
+	}
+	return nil, nil
+}
+
+// Execute CreateApp action (Implements ActionSettings interface)
+func (settings CreateAppSettings) Execute(sessionState *session.State, actionState *action.State, connection *connection.ConnectionSettings, label string, reset func()) {
+	name, err := sessionState.ReplaceSessionVariables(&settings.Name)
+	if err != nil {
+		actionState.AddErrors(err)
+		return
+	}
+
+	uplink, err := connectEngine(sessionState, actionState, connection, label)
+	if err != nil {
+		actionState.AddErrors(errors.WithStack(err))
+		return
+	}
+
+	var appID string
+	if err := sessionState.SendRequest(actionState, func(ctx context.Context) error {
+		success, id, err := uplink.Global.CreateApp(ctx, name, "", "")
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if !success || id == "" {
+			return errors.Errorf("failed to create app<%s>", name)
+		}
+		appID = id
+		return nil
+	}); err != nil {
+		actionState.AddErrors(err)
+		return
+	}
+
+	addAppToArtifactMap(sessionState, name, appID)
+	actionState.Details = fmt.Sprintf("%s;%s", name, appID) // log details in results as {App name};{App ID}
+}
@@ -0,0 +1,177 @@
+package scenario
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/enigma-go/v4"
+	"github.com/qlik-oss/gopherciser/action"
+	"github.com/qlik-oss/gopherciser/connection"
+	"github.com/qlik-oss/gopherciser/enummap"
+	"github.com/qlik-oss/gopherciser/helpers"
+	"github.com/qlik-oss/gopherciser/session"
+	"github.com/qlik-oss/gopherciser/synced"
+)
+
+type (
+	// DatePickerSelectionType how to choose date range
+	DatePickerSelectionType int
+
+	// DatePickerSettings select date range in date picker object
+	DatePickerSettings struct {
+		ID    string                  `json:"id" displayname:"Object ID" doc-key:"datepicker.id" appstructure:"active:qlik-date-picker"`
+		Type  DatePickerSelectionType `json:"type" displayname:"Selection type" doc-key:"datepicker.type"`
+		Start synced.Template         `json:"start,omitempty" displayname:"Start date" doc-key:"datepicker.start"`
+		End   synced.Template         `json:"end,omitempty" displayname:"End date" doc-key:"datepicker.end"`
+	}
+)
+
+// DatePickerSelectionType enum
+const (
+	DatePickerRandom DatePickerSelectionType = iota
+	DatePickerRange
+)
+
+const datePickerObjectType = "qlik-date-picker"
+
+var datePickerSelectionTypeEnumMap = enummap.NewEnumMapOrPanic(map[string]int{
+	"random": int(DatePickerRandom),
+	"range":  int(DatePickerRange),
+})
+
+// GetEnumMap returns date picker selection type enum map to GUI
+func (value DatePickerSelectionType) GetEnumMap() *enummap.EnumMap {
+	return datePickerSelectionTypeEnumMap
+}
+
+// UnmarshalJSON unmarshal DatePickerSelectionType
+func (value *DatePickerSelectionType) UnmarshalJSON(arg []byte) error {
+	i, err := value.GetEnumMap().UnMarshal(arg)
+	if err != nil {
+		return errors.Wrap(err, "Failed to unmarshal DatePickerSelectionType")
+	}
+
+	*value = DatePickerSelectionType(i)
+	return nil
+}
+
+// MarshalJSON marshal DatePickerSelectionType
+func (value DatePickerSelectionType) MarshalJSON() ([]byte, error) {
+	str, err := value.GetEnumMap().String(int(value))
+	if err != nil {
+		return nil, errors.Errorf("Unknown DatePickerSelectionType<%d>", value)
+	}
+	return []byte(fmt.Sprintf(`"%s"`, str)), nil
+}
+
+// String representation of DatePickerSelectionType
+func (value DatePickerSelectionType) String() string {
+	return value.GetEnumMap().StringDefault(int(value), strconv.Itoa(int(value)))
+}
+
+// Validate DatePicker action (Implements ActionSettings interface)
+func (settings DatePickerSettings) Validate() ([]string, error) {
+	if settings.ID == "" {
+		return nil, errors.Errorf("no object id defined for %s", ActionDatePicker)
+	}
+	switch settings.Type {
+	case DatePickerRandom:
+	case DatePickerRange:
+		if settings.Start.String() == "" || settings.End.String() == "" {
+			return nil, errors.Errorf("selection type<%s> requires start and end date", settings.Type)
+		}
+	default:
+		return nil, errors.Errorf("unknown selection type<%d>", settings.Type)
+	}
+	return nil, nil
+}
+
+// Execute DatePicker action (Implements ActionSettings interface)
+func (settings DatePickerSettings) Execute(sessionState *session.State, actionState *action.State, connection *connection.ConnectionSettings, label string, reset func()) {
+	if sessionState.Connection == nil || sessionState.Connection.Sense() == nil {
+		actionState.AddErrors(errors.New("Not connected to a Sense environment"))
+		return
+	}
+
+	// make sure object has latest layout before choosing dates
+	if sessionState.Wait(actionState) {
+		return
+	}
+
+	uplink := sessionState.Connection.Sense()
+	objectID := sessionState.IDMap.Get(settings.ID)
+	obj, err := uplink.Objects.GetObjectByID(objectID)
+	if err != nil {
+		actionState.AddErrors(errors.Wrapf(err, "Failed getting object<%s> from object list", objectID))
+		return
+	}
+	gob, ok := obj.EnigmaObject.(*enigma.GenericObject)
+	if !ok {
+		actionState.AddErrors(errors.Errorf("Failed to cast object<%s> to *enigma.GenericObject", objectID))
+		return
+	}
+	if gob.GenericType != datePickerObjectType {
+		actionState.AddErrors(errors.Errorf("object<%s> type<%s> is not a %s", objectID, gob.GenericType, datePickerObjectType))
+		return
+	}
+
+	var start, end string
+	switch settings.Type {
+	case DatePickerRange:
+		if start, err = sessionState.ReplaceSessionVariables(&settings.Start); err != nil {
+			actionState.AddErrors(err)
+			return
+		}
+		if end, err = sessionState.ReplaceSessionVariables(&settings.End); err != nil {
+			actionState.AddErrors(err)
+			return
+		}
+	default:
+		listObject := obj.ListObject()
+		if listObject == nil || listObject.DimensionInfo == nil {
+			actionState.AddErrors(errors.Errorf("object<%s> has no list object", objectID))
+			return
+		}
+		start, end, err = randomDateRange(sessionState.Randomizer(), float64(listObject.DimensionInfo.Min), float64(listObject.DimensionInfo.Max))
+		if err != nil {
+			actionState.AddErrors(errors.Wrapf(err, "object<%s>", objectID))
+			return
+		}
+	}
+
+	search := dateRangeSearch(start, end)
+	actionState.Details = fmt.Sprintf("%s;%s", objectID, search) // log details in results as {Object ID};{Search}
+
+	// date picker selects the range as a search in the field of the list object
+	queueSelect(sessionState, actionState, gob, false, false, func(ctx context.Context) (bool, error) {
+		found, err := gob.SearchListObjectFor(ctx, "/qListObjectDef", search)
+		if err != nil || !found {
+			return found, errors.WithStack(err)
+		}
+		return true, errors.WithStack(gob.AcceptListObjectSearch(ctx, "/qListObjectDef", false, false))
+	})
+
+	sessionState.Wait(actionState)
+}
+
+// randomDateRange random range of whole days between min and max date
+func randomDateRange(randomizer helpers.Randomizer, min, max float64) (string, string, error) {
+	first, last := int(math.Ceil(min)), int(math.Floor(max))
+	if last < first {
+		return "", "", errors.Errorf("no dates between min<%v> and max<%v>", min, max)
+	}
+	start := first + randomizer.Rand(last-first+1)
+	end := first + randomizer.Rand(last-first+1)
+	if end < start {
+		start, end = end, start
+	}
+	return strconv.Itoa(start), strconv.Itoa(end), nil
+}
+
+// dateRangeSearch search string matching values between start and end
+func dateRangeSearch(start, end string) string {
+	return fmt.Sprintf(">=%s<=%s", start, end)
+}
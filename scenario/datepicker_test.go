@@ -0,0 +1,30 @@
+package scenario
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/qlik-oss/gopherciser/randomizer"
+	"github.com/qlik-oss/gopherciser/session"
+)
+
+func TestRandomDateRange(t *testing.T) {
+	rnd := &session.DefaultRandomizer{Randomizer: randomizer.NewSeededRandomizer(1)}
+	for i := 0; i < 20; i++ {
+		start, end, err := randomDateRange(rnd, 44000.5, 44010)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s, _ := strconv.Atoi(start)
+		e, _ := strconv.Atoi(end)
+		if s < 44001 || e > 44010 || s > e {
+			t.Errorf("unexpected range start<%s> end<%s>", start, end)
+		}
+	}
+	if _, _, err := randomDateRange(rnd, 44000.2, 44000.8); err == nil {
+		t.Error("expected error for range without whole days")
+	}
+	if search := dateRangeSearch("2024-01-01", "2024-01-31"); search != ">=2024-01-01<=2024-01-31" {
+		t.Errorf("unexpected search<%s>", search)
+	}
+}
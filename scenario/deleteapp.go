@@ -0,0 +1,66 @@
+package scenario
+
+import (
+	"context"
+
+	"github.com/goccy/go-json"
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/gopherciser/action"
+	"github.com/qlik-oss/gopherciser/connection"
+	"github.com/qlik-oss/gopherciser/session"
+)
+
+type (
+	// DeleteAppSettings delete app
+	DeleteAppSettings struct {
+		session.AppSelection
+	}
+)
+
+// UnmarshalJSON unmarshals delete app settings from JSON
+func (settings *DeleteAppSettings) UnmarshalJSON(arg []byte) error {
+	if err := json.Unmarshal(arg, &settings.AppSelection); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal action<%s>", ActionDeleteApp)
+	}
+	return nil
+}
+
+// Validate DeleteApp action (Implements ActionSettings interface)
+func (settings DeleteAppSettings) Validate() ([]string, error) {
+	if err := settings.AppSelection.Validate(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return nil, nil
+}
+
+// Execute DeleteApp action (Implements ActionSettings interface)
+func (settings DeleteAppSettings) Execute(sessionState *session.State, actionState *action.State, connection *connection.ConnectionSettings, label string, reset func()) {
+	entry, err := settings.Select(sessionState)
+	if err != nil {
+		actionState.AddErrors(errors.Wrap(err, "Failed to perform app selection"))
+		return
+	}
+	actionState.Details = entry.Name
+
+	uplink, err := connectEngine(sessionState, actionState, connection, label)
+	if err != nil {
+		actionState.AddErrors(errors.WithStack(err))
+		return
+	}
+
+	if err := sessionState.SendRequest(actionState, func(ctx context.Context) error {
+		success, err := uplink.Global.DeleteApp(ctx, entry.ID)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if !success {
+			return errors.Errorf("failed to delete app<%s>", entry.ID)
+		}
+		return nil
+	}); err != nil {
+		actionState.AddErrors(err)
+		return
+	}
+
+	removeAppFromArtifactMap(sessionState, entry.ID)
+}
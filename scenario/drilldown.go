@@ -0,0 +1,240 @@
+package scenario
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/enigma-go/v4"
+	"github.com/qlik-oss/gopherciser/action"
+	"github.com/qlik-oss/gopherciser/connection"
+	"github.com/qlik-oss/gopherciser/enigmahandlers"
+	"github.com/qlik-oss/gopherciser/enummap"
+	"github.com/qlik-oss/gopherciser/globals/constant"
+	"github.com/qlik-oss/gopherciser/logger"
+	"github.com/qlik-oss/gopherciser/senseobjdef"
+	"github.com/qlik-oss/gopherciser/session"
+)
+
+type (
+	// DrillOperation drill down or up in a drill-down dimension
+	DrillOperation int
+
+	// DrillDownSettings drill into or out of a drill-down dimension of a chart
+	DrillDownSettings struct {
+		ID        string         `json:"id" displayname:"Object ID" doc-key:"drilldown.id" appstructure:"selectable:!sheet"`
+		Operation DrillOperation `json:"operation" displayname:"Operation" doc-key:"drilldown.operation"`
+		Dimension int            `json:"dim" displayname:"Dimension" doc-key:"drilldown.dim"`
+		Target    TargetType     `json:"target" displayname:"Target" doc-key:"drilldown.target"`
+		Row       int            `json:"row,omitempty" displayname:"Row" doc-key:"drilldown.row"`
+		Steps     int            `json:"steps,omitempty" displayname:"Steps" doc-key:"drilldown.steps"`
+	}
+)
+
+// DrillOperation enum
+const (
+	DrillDown DrillOperation = iota
+	DrillUp
+)
+
+var drillOperationEnumMap = enummap.NewEnumMapOrPanic(map[string]int{
+	"down": int(DrillDown),
+	"up":   int(DrillUp),
+})
+
+// GetEnumMap returns drill operation enum map to GUI
+func (value DrillOperation) GetEnumMap() *enummap.EnumMap {
+	return drillOperationEnumMap
+}
+
+// UnmarshalJSON unmarshal DrillOperation
+func (value *DrillOperation) UnmarshalJSON(arg []byte) error {
+	i, err := value.GetEnumMap().UnMarshal(arg)
+	if err != nil {
+		return errors.Wrap(err, "Failed to unmarshal DrillOperation")
+	}
+
+	*value = DrillOperation(i)
+	return nil
+}
+
+// MarshalJSON marshal DrillOperation
+func (value DrillOperation) MarshalJSON() ([]byte, error) {
+	str, err := value.GetEnumMap().String(int(value))
+	if err != nil {
+		return nil, errors.Errorf("Unknown DrillOperation<%d>", value)
+	}
+	return []byte(fmt.Sprintf(`"%s"`, str)), nil
+}
+
+// String representation of DrillOperation
+func (value DrillOperation) String() string {
+	return value.GetEnumMap().StringDefault(int(value), strconv.Itoa(int(value)))
+}
+
+// Validate DrillDownSettings action (Implements ActionSettings interface)
+func (settings DrillDownSettings) Validate() ([]string, error) {
+	if settings.ID == "" {
+		return nil, errors.Errorf("no object id defined for %s", ActionDrillDown)
+	}
+	if _, err := drillOperationEnumMap.String(int(settings.Operation)); err != nil {
+		return nil, errors.Errorf("unknown drill operation<%d>", settings.Operation)
+	}
+	if _, err := targetTypeEnumMap.String(int(settings.Target)); err != nil {
+		return nil, errors.Errorf("unknown target<%d>", settings.Target)
+	}
+	if settings.Dimension < 0 {
+		return nil, errors.Errorf("illegal dimension<%d>", settings.Dimension)
+	}
+	if settings.Row < 0 {
+		return nil, errors.Errorf("illegal row<%d>", settings.Row)
+	}
+	if settings.Steps < 0 {
+		return nil, errors.Errorf("illegal steps<%d>", settings.Steps)
+	}
+	return nil, nil
+}
+
+// Execute DrillDownSettings action (Implements ActionSettings interface)
+func (settings DrillDownSettings) Execute(sessionState *session.State, actionState *action.State, connection *connection.ConnectionSettings, label string, reset func()) {
+	if sessionState.Connection == nil || sessionState.Connection.Sense() == nil {
+		actionState.AddErrors(errors.New("Not connected to a Sense environment"))
+		return
+	}
+
+	// make sure object has latest data before choosing value
+	if sessionState.Wait(actionState) {
+		return
+	}
+
+	uplink := sessionState.Connection.Sense()
+	objectID := sessionState.IDMap.Get(settings.ID)
+	obj, err := uplink.Objects.GetObjectByID(objectID)
+	if err != nil {
+		actionState.AddErrors(errors.Wrapf(err, "Failed getting object<%s> from object list", objectID))
+		return
+	}
+
+	if linkedObjHandle := uplink.Objects.GetObjectLink(obj.Handle); linkedObjHandle != 0 {
+		obj, err = uplink.Objects.GetObject(linkedObjHandle)
+		if err != nil {
+			actionState.AddErrors(errors.Wrapf(err, "Failed getting linked object<%d> object<%s>", linkedObjHandle, objectID))
+			return
+		}
+	}
+
+	gob, ok := obj.EnigmaObject.(*enigma.GenericObject)
+	if !ok {
+		actionState.AddErrors(errors.Errorf("Failed to cast object<%s> to *enigma.GenericObject", objectID))
+		return
+	}
+
+	hypercube := obj.HyperCube()
+	if hypercube == nil || hypercube.HyperCube == nil {
+		actionState.AddErrors(errors.Errorf("object<%s> has no hypercube", objectID))
+		return
+	}
+	if err := verifyDimension(objectID, settings.Dimension, hypercube.DimensionInfo); err != nil {
+		actionState.AddErrors(errors.WithStack(err))
+		return
+	}
+	dimInfo := hypercube.DimensionInfo[settings.Dimension]
+	if dimInfo.Grouping != constant.NxDimensionInfoGroupingHiearchy {
+		actionState.AddErrors(errors.Errorf("object<%s> dimension<%d> is not a drill-down dimension", objectID, settings.Dimension))
+		return
+	}
+
+	objInstance := sessionState.GetObjectHandlerInstance(gob.GenericId, gob.GenericType)
+	selectPath, selectType, _, err := objInstance.GetObjectDefinition(gob.GenericType)
+	if err != nil {
+		actionState.AddErrors(errors.WithStack(err))
+		return
+	}
+	if selectType == senseobjdef.SelectTypeUnknown {
+		actionState.AddErrors(errors.Errorf("object<%s> type<%s> does not have a select definition", gob.GenericId, gob.GenericType))
+		return
+	}
+
+	switch settings.Operation {
+	case DrillUp:
+		steps := settings.Steps
+		if steps < 1 {
+			steps = 1
+		}
+		if dimInfo.GroupPos < 1 {
+			sessionState.LogEntry.Logf(logger.WarningLevel, "object<%s> dimension<%d> is already at top level", objectID, settings.Dimension)
+			return
+		}
+		actionState.Details = fmt.Sprintf("%s;%s;%d", objectID, settings.Operation, steps) // log details in results as {Object ID};{Operation};{Steps}
+		err = sessionState.SendRequest(actionState, func(ctx context.Context) error {
+			return gob.DrillUp(ctx, selectPath, settings.Dimension, steps)
+		})
+		if err != nil {
+			actionState.AddErrors(errors.Wrapf(err, "object<%s> failed to drill up", objectID))
+			return
+		}
+	default:
+		if dimInfo.GroupPos >= len(dimInfo.GroupFieldDefs)-1 {
+			sessionState.LogEntry.Logf(logger.WarningLevel, "object<%s> dimension<%d> is already at lowest level", objectID, settings.Dimension)
+			return
+		}
+
+		elemNo, err := settings.drillValue(sessionState, obj, selectType == senseobjdef.SelectTypeHypercubeColumnValues)
+		if err != nil {
+			actionState.AddErrors(errors.WithStack(err))
+			return
+		}
+		if elemNo < 0 {
+			sessionState.LogEntry.Logf(logger.WarningLevel, "object<%s> has no values to drill into", objectID)
+			return
+		}
+
+		actionState.Details = fmt.Sprintf("%s;%s;%d", objectID, settings.Operation, elemNo) // log details in results as {Object ID};{Operation};{Element number}
+		err = sessionState.SendRequest(actionState, func(ctx context.Context) error {
+			success, err := gob.SelectHyperCubeValues(ctx, selectPath, settings.Dimension, []int{elemNo}, false)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if !success {
+				return errors.Errorf("select of element<%d> unsuccessful", elemNo)
+			}
+			return nil
+		})
+		if err != nil {
+			actionState.AddErrors(errors.Wrapf(err, "object<%s> failed to drill down", objectID))
+			return
+		}
+	}
+
+	// Await object updates, changed objects fetch resulting data pages according to object definitions
+	sessionState.Wait(actionState)
+}
+
+// drillValue element number to drill into, -1 if there is no value to drill into
+func (settings DrillDownSettings) drillValue(sessionState *session.State, obj *enigmahandlers.Object, columns bool) (int, error) {
+	hypercube := obj.HyperCube()
+	if settings.Target == TargetRandom {
+		possible, err := getPossibleFromStraightHyperCube(obj.ID, hypercube, settings.Dimension, columns, RandomFromEnabled)
+		if err != nil {
+			return -1, errors.WithStack(err)
+		}
+		if len(possible) < 1 {
+			return -1, nil
+		}
+		return possible[sessionState.Randomizer().Rand(len(possible))], nil
+	}
+
+	dataPages, err := getHypercubeDataPages(obj.ID, hypercube)
+	if err != nil {
+		return -1, errors.WithStack(err)
+	}
+	matrix, dim, err := getMatrix(dataPages, settings.Dimension, columns)
+	if err != nil {
+		return -1, errors.WithStack(err)
+	}
+	if settings.Row >= len(matrix) || dim >= len(matrix[settings.Row]) || matrix[settings.Row][dim] == nil {
+		return -1, errors.Errorf("object<%s> has no value on row<%d> in dimension<%d>", obj.ID, settings.Row, settings.Dimension)
+	}
+	return matrix[settings.Row][dim].ElemNumber, nil
+}
@@ -0,0 +1,69 @@
+package scenario
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/qlik-oss/enigma-go/v4"
+	"github.com/qlik-oss/gopherciser/enigmahandlers"
+)
+
+func TestDrillDownSettingsUnmarshal(t *testing.T) {
+	raw := `{
+		"id": "bar1",
+		"operation": "up",
+		"dim": 1,
+		"steps": 2
+	}`
+
+	var settings DrillDownSettings
+	if err := json.Unmarshal([]byte(raw), &settings); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := settings.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	expected := DrillDownSettings{ID: "bar1", Operation: DrillUp, Dimension: 1, Target: TargetRandom, Steps: 2}
+	if settings != expected {
+		t.Errorf("unexpected settings<%+v> expected<%+v>", settings, expected)
+	}
+
+	invalid := []DrillDownSettings{
+		{},
+		{ID: "bar1", Operation: DrillOperation(4)},
+		{ID: "bar1", Dimension: -1},
+		{ID: "bar1", Steps: -1},
+	}
+	for _, settings := range invalid {
+		if _, err := settings.Validate(); err == nil {
+			t.Errorf("expected validation error for<%+v>", settings)
+		}
+	}
+}
+
+func TestDrillValue(t *testing.T) {
+	obj := enigmahandlers.NewObject(1, enigmahandlers.ObjTypeGenericObject, "bar1", nil)
+	obj.SetHyperCube(&enigma.HyperCube{Size: &enigma.Size{Cx: 2, Cy: 2}})
+	if err := obj.SetHyperCubeDataPages([]*enigma.NxDataPage{{
+		Matrix: []enigma.NxCellRows{
+			{{Text: "Sweden", ElemNumber: 4, State: "O"}, {Text: "12", Num: 12}},
+			{{Text: "Norway", ElemNumber: 7, State: "O"}, {Text: "3", Num: 3}},
+		},
+	}}, false); err != nil {
+		t.Fatal(err)
+	}
+
+	settings := DrillDownSettings{ID: "bar1", Target: TargetIndex, Row: 1}
+	elemNo, err := settings.drillValue(nil, obj, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if elemNo != 7 {
+		t.Errorf("unexpected element<%d> expected<7>", elemNo)
+	}
+
+	settings.Row = 2
+	if _, err := settings.drillValue(nil, obj, false); err == nil {
+		t.Error("expected error for row outside of data")
+	}
+}
@@ -0,0 +1,84 @@
+package scenario
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/goccy/go-json"
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/gopherciser/action"
+	"github.com/qlik-oss/gopherciser/connection"
+	"github.com/qlik-oss/gopherciser/session"
+	"github.com/qlik-oss/gopherciser/synced"
+)
+
+type (
+	// DuplicateAppSettingsCore duplicate app settings
+	DuplicateAppSettingsCore struct {
+		Name synced.Template `json:"name,omitempty" displayname:"Name of copy" doc-key:"duplicateapp.name"`
+	}
+
+	// DuplicateAppSettings duplicate app using QRS
+	DuplicateAppSettings struct {
+		session.AppSelection
+		DuplicateAppSettingsCore
+	}
+)
+
+// UnmarshalJSON unmarshals duplicate app settings from JSON
+func (settings *DuplicateAppSettings) UnmarshalJSON(arg []byte) error {
+	if err := json.Unmarshal(arg, &settings.DuplicateAppSettingsCore); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal action<%s>", ActionDuplicateApp)
+	}
+	if err := json.Unmarshal(arg, &settings.AppSelection); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal action<%s>", ActionDuplicateApp)
+	}
+	return nil
+}
+
+// Validate DuplicateApp action (Implements ActionSettings interface)
+func (settings DuplicateAppSettings) Validate() ([]string, error) {
+	if err := settings.AppSelection.Validate(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return nil, nil
+}
+
+// Execute DuplicateApp action (Implements ActionSettings interface)
+func (settings DuplicateAppSettings) Execute(sessionState *session.State, actionState *action.State, connection *connection.ConnectionSettings, label string, reset func()) {
+	entry, err := settings.Select(sessionState)
+	if err != nil {
+		actionState.AddErrors(errors.Wrap(err, "Failed to perform app selection"))
+		return
+	}
+
+	// QRS names the copy when no name is given
+	query := url.Values{}
+	if settings.Name.String() != "" {
+		name, err := sessionState.ReplaceSessionVariables(&settings.Name)
+		if err != nil {
+			actionState.AddErrors(err)
+			return
+		}
+		query.Set("name", name)
+	}
+
+	copyUrl, err := qrsUrl(sessionState, connection, fmt.Sprintf("app/%s/copy", entry.ID), query)
+	if err != nil {
+		actionState.AddErrors(err)
+		return
+	}
+
+	req, err := sessionState.Rest.PostSync(copyUrl, actionState, sessionState.LogEntry, nil, qrsCreateOptions())
+	if err != nil {
+		return // failed request is already reported on action state
+	}
+	app, err := unmarshalQrsApp(req)
+	if err != nil {
+		actionState.AddErrors(errors.Wrapf(err, "failed to duplicate app<%s>", entry.ID))
+		return
+	}
+
+	addAppToArtifactMap(sessionState, app.Name, app.ID)
+	actionState.Details = fmt.Sprintf("%s;%s;%s", entry.ID, app.Name, app.ID) // log details in results as {Source app ID};{App name};{App ID}
+}
@@ -0,0 +1,103 @@
+package scenario
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/gopherciser/action"
+	"github.com/qlik-oss/gopherciser/connection"
+	"github.com/qlik-oss/gopherciser/logger"
+	"github.com/qlik-oss/gopherciser/session"
+	"github.com/qlik-oss/gopherciser/structs"
+)
+
+type (
+	// ExportAppSettingsCore export app settings
+	ExportAppSettingsCore struct {
+		NoData bool `json:"nodata,omitempty" displayname:"No data" doc-key:"exportapp.nodata"`
+	}
+
+	// ExportAppSettings export app using QRS and download resulting file
+	ExportAppSettings struct {
+		session.AppSelection
+		ExportAppSettingsCore
+	}
+)
+
+// UnmarshalJSON unmarshals export app settings from JSON
+func (settings *ExportAppSettings) UnmarshalJSON(arg []byte) error {
+	if err := json.Unmarshal(arg, &settings.ExportAppSettingsCore); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal action<%s>", ActionExportApp)
+	}
+	if err := json.Unmarshal(arg, &settings.AppSelection); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal action<%s>", ActionExportApp)
+	}
+	return nil
+}
+
+// Validate ExportApp action (Implements ActionSettings interface)
+func (settings ExportAppSettings) Validate() ([]string, error) {
+	if err := settings.AppSelection.Validate(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return nil, nil
+}
+
+// Execute ExportApp action (Implements ActionSettings interface)
+func (settings ExportAppSettings) Execute(sessionState *session.State, actionState *action.State, connection *connection.ConnectionSettings, label string, reset func()) {
+	entry, err := settings.Select(sessionState)
+	if err != nil {
+		actionState.AddErrors(errors.Wrap(err, "Failed to perform app selection"))
+		return
+	}
+
+	host, err := connection.RestUrl()
+	if err != nil {
+		actionState.AddErrors(errors.WithStack(err))
+		return
+	}
+
+	exportEndpoint := fmt.Sprintf("app/%s/export/%s", entry.ID, uuid.NewString())
+	query := url.Values{}
+	query.Set("skipData", fmt.Sprintf("%t", settings.NoData))
+	exportUrl, err := qrsUrl(sessionState, connection, exportEndpoint, query)
+	if err != nil {
+		actionState.AddErrors(err)
+		return
+	}
+
+	req, err := sessionState.Rest.PostSync(exportUrl, actionState, sessionState.LogEntry, nil, qrsCreateOptions())
+	if err != nil {
+		return // failed request is already reported on action state
+	}
+	var export structs.QrsAppExport
+	if err := json.Unmarshal(req.ResponseBody, &export); err != nil {
+		actionState.AddErrors(errors.Wrapf(err, "failed unmarshaling export response: %s", req.ResponseBody))
+		return
+	}
+	if export.DownloadPath == "" {
+		actionState.AddErrors(errors.Errorf("export of app<%s> returned no download path", entry.ID))
+		return
+	}
+
+	req, err = sessionState.Rest.GetSync(strings.TrimSuffix(host, "/")+export.DownloadPath, actionState, sessionState.LogEntry, nil)
+	if err != nil {
+		return // failed request is already reported on action state
+	}
+	size := len(req.ResponseBody)
+
+	// remove export ticket, failure to do so does not fail the export itself
+	if deleteUrl, err := qrsUrl(sessionState, connection, exportEndpoint, nil); err == nil {
+		options := session.DefaultReqOptions()
+		options.FailOnError = false
+		if _, err := sessionState.Rest.DeleteSync(deleteUrl, actionState, sessionState.LogEntry, options); err != nil {
+			sessionState.LogEntry.Logf(logger.WarningLevel, "failed to remove export of app<%s>: %v", entry.ID, err)
+		}
+	}
+
+	actionState.Details = fmt.Sprintf("%s;%d", entry.ID, size) // log details in results as {App ID};{File size}
+}
@@ -0,0 +1,305 @@
+package scenario
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/enigma-go/v4"
+	"github.com/qlik-oss/gopherciser/action"
+	"github.com/qlik-oss/gopherciser/connection"
+	"github.com/qlik-oss/gopherciser/enigmahandlers"
+	"github.com/qlik-oss/gopherciser/enummap"
+	"github.com/qlik-oss/gopherciser/globals/constant"
+	"github.com/qlik-oss/gopherciser/logger"
+	"github.com/qlik-oss/gopherciser/senseobjdef"
+	"github.com/qlik-oss/gopherciser/session"
+)
+
+type (
+	// ExportFileType file format of exported data
+	ExportFileType int
+	// ExportState which values to export
+	ExportState int
+
+	// ExportDataSettings export data of object, or of all objects on a sheet, and download resulting file
+	ExportDataSettings struct {
+		ID          string         `json:"id" displayname:"Object or sheet ID" doc-key:"exportdata.id"`
+		FileType    ExportFileType `json:"filetype" displayname:"File type" doc-key:"exportdata.filetype"`
+		ExportState ExportState    `json:"exportstate" displayname:"Export state" doc-key:"exportdata.exportstate"`
+		ServeOnce   bool           `json:"serveonce,omitempty" displayname:"Serve once" doc-key:"exportdata.serveonce"`
+	}
+
+	// exportObject object to export and path to its hypercube definition
+	exportObject struct {
+		*enigma.GenericObject
+		Path string
+	}
+
+	// exportableInfo info of object with data and its object definition
+	exportableInfo struct {
+		Info *enigma.NxInfo
+		Def  *senseobjdef.ObjectDef
+	}
+
+	// exportedFile result of one export
+	exportedFile struct {
+		ObjectID       string
+		GenerationTime time.Duration
+		Size           int
+	}
+)
+
+// ExportFileType enum
+const (
+	ExportFileTypeOOXML ExportFileType = iota
+	ExportFileTypeCSVComma
+	ExportFileTypeCSVTab
+)
+
+// ExportState enum
+const (
+	ExportStatePossible ExportState = iota
+	ExportStateAll
+)
+
+var (
+	exportFileTypeEnumMap = enummap.NewEnumMapOrPanic(map[string]int{
+		"ooxml": int(ExportFileTypeOOXML),
+		"csv_c": int(ExportFileTypeCSVComma),
+		"csv_t": int(ExportFileTypeCSVTab),
+	})
+
+	exportStateEnumMap = enummap.NewEnumMapOrPanic(map[string]int{
+		"possible": int(ExportStatePossible),
+		"all":      int(ExportStateAll),
+	})
+)
+
+// GetEnumMap returns export file type enum map to GUI
+func (value ExportFileType) GetEnumMap() *enummap.EnumMap {
+	return exportFileTypeEnumMap
+}
+
+// UnmarshalJSON unmarshal ExportFileType
+func (value *ExportFileType) UnmarshalJSON(arg []byte) error {
+	i, err := value.GetEnumMap().UnMarshal(arg)
+	if err != nil {
+		return errors.Wrap(err, "Failed to unmarshal ExportFileType")
+	}
+
+	*value = ExportFileType(i)
+	return nil
+}
+
+// MarshalJSON marshal ExportFileType
+func (value ExportFileType) MarshalJSON() ([]byte, error) {
+	str, err := value.GetEnumMap().String(int(value))
+	if err != nil {
+		return nil, errors.Errorf("Unknown ExportFileType<%d>", value)
+	}
+	return []byte(fmt.Sprintf(`"%s"`, str)), nil
+}
+
+// String representation of ExportFileType
+func (value ExportFileType) String() string {
+	return value.GetEnumMap().StringDefault(int(value), strconv.Itoa(int(value)))
+}
+
+// GetEnumMap returns export state enum map to GUI
+func (value ExportState) GetEnumMap() *enummap.EnumMap {
+	return exportStateEnumMap
+}
+
+// UnmarshalJSON unmarshal ExportState
+func (value *ExportState) UnmarshalJSON(arg []byte) error {
+	i, err := value.GetEnumMap().UnMarshal(arg)
+	if err != nil {
+		return errors.Wrap(err, "Failed to unmarshal ExportState")
+	}
+
+	*value = ExportState(i)
+	return nil
+}
+
+// MarshalJSON marshal ExportState
+func (value ExportState) MarshalJSON() ([]byte, error) {
+	str, err := value.GetEnumMap().String(int(value))
+	if err != nil {
+		return nil, errors.Errorf("Unknown ExportState<%d>", value)
+	}
+	return []byte(fmt.Sprintf(`"%s"`, str)), nil
+}
+
+// String representation of ExportState
+func (value ExportState) String() string {
+	return value.GetEnumMap().StringDefault(int(value), strconv.Itoa(int(value)))
+}
+
+// engineValue export state as sent to engine, "P" for possible values and "A" for all values
+func (value ExportState) engineValue() string {
+	if value == ExportStateAll {
+		return "A"
+	}
+	return "P"
+}
+
+// Validate ExportData action (Implements ActionSettings interface)
+func (settings ExportDataSettings) Validate() ([]string, error) {
+	if settings.ID == "" {
+		return nil, errors.Errorf("no object id defined for %s", ActionExportData)
+	}
+	if _, err := exportFileTypeEnumMap.String(int(settings.FileType)); err != nil {
+		return nil, errors.Errorf("unknown file type<%d>", settings.FileType)
+	}
+	if _, err := exportStateEnumMap.String(int(settings.ExportState)); err != nil {
+		return nil, errors.Errorf("unknown export state<%d>", settings.ExportState)
+	}
+	return nil, nil
+}
+
+// Execute ExportData action (Implements ActionSettings interface)
+func (settings ExportDataSettings) Execute(sessionState *session.State, actionState *action.State, connection *connection.ConnectionSettings, label string, reset func()) {
+	if sessionState.Connection == nil || sessionState.Connection.Sense() == nil {
+		actionState.AddErrors(errors.New("Not connected to a Sense environment"))
+		return
+	}
+	if sessionState.Connection.Sense().CurrentApp == nil {
+		actionState.AddErrors(errors.New("Not connected to a Sense app"))
+		return
+	}
+
+	host, err := connection.RestUrl()
+	if err != nil {
+		actionState.AddErrors(errors.WithStack(err))
+		return
+	}
+
+	// get objects to export, all objects with data on sheet when id is a sheet
+	uplink := sessionState.Connection.Sense()
+	objectID := sessionState.IDMap.Get(settings.ID)
+	var objects []exportObject
+	err = sessionState.SendRequest(actionState, func(ctx context.Context) error {
+		obj, err := getExportObject(ctx, uplink, objectID)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if obj.GenericType != "sheet" {
+			path, err := hyperCubeDefPath(obj)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			objects = append(objects, exportObject{obj, path})
+			return nil
+		}
+
+		infos, err := obj.GetChildInfos(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get child infos for sheet<%s>", objectID)
+		}
+		for _, exportable := range exportableInfos(infos) {
+			path, err := exportable.Def.HyperCubeDefPath()
+			if err != nil {
+				return errors.Wrapf(err, "object<%s> type<%s>", exportable.Info.Id, exportable.Info.Type)
+			}
+			child, err := getExportObject(ctx, uplink, exportable.Info.Id)
+			if err != nil {
+				return errors.Wrapf(err, "failed to get object on sheet<%s>", objectID)
+			}
+			objects = append(objects, exportObject{child, path})
+		}
+		return nil
+	})
+	if err != nil {
+		actionState.AddErrors(err)
+		return
+	}
+	if len(objects) < 1 {
+		sessionState.LogEntry.Logf(logger.WarningLevel, "sheet<%s> has no objects to export data from", objectID)
+		return
+	}
+
+	totalSize := 0
+	for _, obj := range objects {
+		file, err := settings.export(sessionState, actionState, obj.GenericObject, obj.Path, host)
+		if err != nil {
+			actionState.AddErrors(errors.WithStack(err))
+			return
+		}
+		totalSize += file.Size
+		// log export as {Object ID};{Generation time (ms)};{File size (bytes)}
+		sessionState.LogEntry.LogInfo("ExportData", fmt.Sprintf("%s;%d;%d", file.ObjectID, file.GenerationTime.Milliseconds(), file.Size))
+	}
+
+	actionState.Details = fmt.Sprintf("%s;%s;%d;%d", objectID, settings.FileType, len(objects), totalSize) // log details in results as {ID};{File type};{Exported objects};{Total size}
+}
+
+// export data of object and download resulting file
+func (settings ExportDataSettings) export(sessionState *session.State, actionState *action.State, obj *enigma.GenericObject, path, host string) (*exportedFile, error) {
+	file := &exportedFile{ObjectID: obj.GenericId}
+
+	var fileURL string
+	err := sessionState.SendRequest(actionState, func(ctx context.Context) error {
+		start := time.Now()
+		var warnings []int
+		var err error
+		fileURL, warnings, err = obj.ExportData(ctx, strings.ToUpper(settings.FileType.String()), path, "", settings.ExportState.engineValue(), settings.ServeOnce)
+		file.GenerationTime = time.Since(start)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		for _, warning := range warnings {
+			if warning == constant.LocwarnExportDataTruncated {
+				sessionState.LogEntry.Logf(logger.WarningLevel, "exported data of object<%s> truncated", obj.GenericId)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to export data of object<%s>", obj.GenericId)
+	}
+	if fileURL == "" {
+		return nil, errors.Errorf("export data of object<%s> returned empty url", obj.GenericId)
+	}
+
+	req, err := sessionState.Rest.GetSync(strings.TrimSuffix(host, "/")+fileURL, actionState, sessionState.LogEntry, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to download exported data of object<%s>", obj.GenericId)
+	}
+	file.Size = len(req.ResponseBody)
+	return file, nil
+}
+
+// getExportObject returns subscribed object with id, only objects not subscribed are fetched from engine. Subscribed
+// objects are reused as the engine has no method to release the handle of a fetched object.
+func getExportObject(ctx context.Context, uplink *enigmahandlers.SenseUplink, id string) (*enigma.GenericObject, error) {
+	if obj, err := uplink.Objects.GetObjectByID(id); err == nil {
+		if gob, ok := obj.EnigmaObject.(*enigma.GenericObject); ok {
+			return gob, nil
+		}
+	}
+	gob, err := uplink.CurrentApp.Doc.GetObject(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get object<%s>", id)
+	}
+	return gob, nil
+}
+
+// exportableInfos filters object infos on objects of types with hypercube data
+func exportableInfos(infos []*enigma.NxInfo) []exportableInfo {
+	exportable := make([]exportableInfo, 0, len(infos))
+	for _, info := range infos {
+		if info == nil {
+			continue
+		}
+		def, err := senseobjdef.GetObjectDef(info.Type)
+		if err != nil || def == nil || def.DataDef.Type != senseobjdef.DataDefHyperCube {
+			continue
+		}
+		exportable = append(exportable, exportableInfo{Info: info, Def: def})
+	}
+	return exportable
+}
@@ -0,0 +1,59 @@
+package scenario
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/qlik-oss/enigma-go/v4"
+)
+
+func TestExportDataSettingsUnmarshal(t *testing.T) {
+	raw := `{
+		"id": "objid1",
+		"filetype": "csv_t",
+		"exportstate": "all"
+	}`
+
+	var settings ExportDataSettings
+	if err := json.Unmarshal([]byte(raw), &settings); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := settings.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if settings.FileType != ExportFileTypeCSVTab || settings.ExportState != ExportStateAll {
+		t.Errorf("unexpected settings<%+v>", settings)
+	}
+	if state := settings.ExportState.engineValue(); state != "A" {
+		t.Errorf("unexpected engine export state<%s>", state)
+	}
+
+	if _, err := (ExportDataSettings{}).Validate(); err == nil {
+		t.Error("expected validation error for missing id")
+	}
+}
+
+func TestExportableInfos(t *testing.T) {
+	infos := []*enigma.NxInfo{
+		{Id: "a", Type: "table"},
+		{Id: "b", Type: "sn-video-player"},
+		nil,
+		{Id: "c", Type: "barchart"},
+		{Id: "d", Type: "listbox"},
+		{Id: "e", Type: "unknown-extension"},
+	}
+	exportable := exportableInfos(infos)
+	if len(exportable) != 2 || exportable[0].Info.Id != "a" || exportable[1].Info.Id != "c" {
+		ids := make([]string, 0, len(exportable))
+		for _, e := range exportable {
+			ids = append(ids, e.Info.Id)
+		}
+		t.Errorf("unexpected exportable objects<%v>", ids)
+		return
+	}
+	for _, e := range exportable {
+		if path, err := e.Def.HyperCubeDefPath(); err != nil || path != "/qHyperCubeDef" {
+			t.Errorf("object<%s> unexpected hypercube def path<%s> err<%v>", e.Info.Id, path, err)
+		}
+	}
+}
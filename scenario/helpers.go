@@ -178,3 +178,118 @@ func thinkTimeWithFallback(thinkTime ThinkTimeSettings, fallback ThinkTimeSettin
 	}
 	return thinkTime
 }
+
+// trackStateSelections make sure current selections of alternate state are tracked, as done by the selection bar
+// when objects on sheet are bound to the state
+func trackStateSelections(sessionState *session.State, actionState *action.State, state string) error {
+	if senseobjects.IsDefaultState(state) {
+		return nil
+	}
+	app := sessionState.Connection.Sense().CurrentApp
+	if app == nil {
+		return errors.New("Not connected to a Sense app")
+	}
+	_, err := app.GetStateCurrentSelections(sessionState, actionState, state, true)
+	return errors.Wrapf(err, "failed to get current selections of state<%s>", state)
+}
+
+// verifyObjectState verify object is bound to alternate state, selections in object are made in the state the object
+// is bound to
+func verifyObjectState(obj *enigmahandlers.Object, state string) error {
+	if state == "" {
+		return nil
+	}
+
+	objState := ""
+	if hypercube := obj.HyperCube(); hypercube != nil && hypercube.HyperCube != nil {
+		objState = hypercube.StateName
+	} else if listobject := obj.ListObject(); listobject != nil {
+		objState = listobject.StateName
+	}
+
+	if senseobjects.IsDefaultState(objState) && senseobjects.IsDefaultState(state) {
+		return nil
+	}
+	if objState != state {
+		if objState == "" {
+			objState = senseobjects.DefaultState
+		}
+		return errors.Errorf("object<%s> is bound to state<%s>, not to state<%s>", obj.ID, objState, state)
+	}
+	return nil
+}
+
+// executeAppSelectionRequest send request changing selections of app, e.g. clear all or back, and await resulting
+// object updates
+func executeAppSelectionRequest(sessionState *session.State, actionState *action.State, state, failMsg string, request func(ctx context.Context, app *senseobjects.App) error) {
+	if sessionState.Connection == nil || sessionState.Connection.Sense() == nil {
+		actionState.AddErrors(errors.New("Not connected to a Sense environment"))
+		return
+	}
+
+	app := sessionState.Connection.Sense().CurrentApp
+	if app == nil {
+		actionState.AddErrors(errors.New("Not connected to a Sense app"))
+		return
+	}
+
+	// Send GetApplayout request, synchronously or otherwise it will be aborted by selection request (and re-sent)
+	err := sessionState.SendRequest(actionState, func(ctx context.Context) error {
+		_, err := app.Doc.GetAppLayout(ctx)
+		return errors.WithStack(err)
+	})
+	if err != nil {
+		actionState.AddErrors(errors.Wrap(err, "GetAppLayout request failed"))
+	}
+
+	if err := trackStateSelections(sessionState, actionState, state); err != nil {
+		actionState.AddErrors(errors.WithStack(err))
+		return
+	}
+
+	sessionState.QueueRequest(func(ctx context.Context) error {
+		return request(ctx, app)
+	}, actionState, true, failMsg)
+
+	sessionState.Wait(actionState)
+}
+
+// connectEngine makes sure there is a websocket connection towards engine, connects without opening an app when not connected
+func connectEngine(sessionState *session.State, actionState *action.State, connectionSettings *connection.ConnectionSettings, label string) (*enigmahandlers.SenseUplink, error) {
+	if sessionState.Connection != nil && sessionState.Connection.Sense() != nil {
+		return sessionState.Connection.Sense(), nil
+	}
+	if err := connectEngineWs(sessionState, actionState, connectionSettings, label, ""); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return sessionState.Connection.Sense(), nil
+}
+
+// connectEngineWs connects a new websocket towards engine for app GUID, logged as a separate action
+func connectEngineWs(sessionState *session.State, actionState *action.State, connectionSettings *connection.ConnectionSettings, label, appGUID string) error {
+	connectFunc, err := connectionSettings.GetConnectFunc(sessionState, appGUID, "", nil, sessionState.Timeout)
+	if err != nil {
+		return errors.Wrap(err, "Failed to get connect function")
+	}
+
+	var wsLabel string
+	if label != "" {
+		wsLabel = fmt.Sprintf("%s - WS", label)
+	}
+	connectWs := GetConnectWsAction(wsLabel, 0, connectFunc)
+
+	// connect websocket and log as separate action
+	actionState.NoResults = true
+	defer func() { actionState.NoResults = false }()
+	if isAborted, err := CheckActionError(connectWs.Execute(sessionState, connectionSettings)); isAborted {
+		return errors.New("connect websocket aborted")
+	} else if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if sessionState.Connection == nil || sessionState.Connection.Sense() == nil {
+		return errors.New("Not connected to a Sense environment")
+	}
+	sessionState.SetReconnectFunc(connectFunc)
+	return nil
+}
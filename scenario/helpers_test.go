@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/pkg/errors"
+	"github.com/qlik-oss/enigma-go/v4"
+	"github.com/qlik-oss/gopherciser/enigmahandlers"
 	"github.com/qlik-oss/gopherciser/helpers"
 )
 
@@ -85,3 +87,35 @@ func TestSetThinkTimeIfNotSet(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyObjectState(t *testing.T) {
+	defaultObj := enigmahandlers.NewObject(1, enigmahandlers.ObjTypeGenericObject, "obj1", nil)
+	defaultObj.SetHyperCube(&enigma.HyperCube{StateName: "$"})
+
+	stateObj := enigmahandlers.NewObject(2, enigmahandlers.ObjTypeGenericObject, "obj2", nil)
+	stateObj.SetListObject(&enigma.ListObject{StateName: "GroupA"})
+
+	cases := []struct {
+		obj   *enigmahandlers.Object
+		state string
+		valid bool
+	}{
+		{defaultObj, "", true},
+		{defaultObj, "$", true},
+		{defaultObj, "GroupA", false},
+		{stateObj, "", true},
+		{stateObj, "GroupA", true},
+		{stateObj, "GroupB", false},
+		{stateObj, "$", false},
+	}
+
+	for _, c := range cases {
+		err := verifyObjectState(c.obj, c.state)
+		if c.valid && err != nil {
+			t.Errorf("object<%s> state<%s> unexpected error: %v", c.obj.ID, c.state, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("object<%s> state<%s> expected error", c.obj.ID, c.state)
+		}
+	}
+}
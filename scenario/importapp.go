@@ -0,0 +1,98 @@
+package scenario
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/gopherciser/action"
+	"github.com/qlik-oss/gopherciser/connection"
+	"github.com/qlik-oss/gopherciser/logger"
+	"github.com/qlik-oss/gopherciser/session"
+	"github.com/qlik-oss/gopherciser/synced"
+)
+
+type (
+	// ImportAppSettings upload app file from disk using QRS
+	ImportAppSettings struct {
+		Filename string          `json:"filename" displayname:"App filename" displayelement:"file" doc-key:"importapp.filename"`
+		Name     synced.Template `json:"name,omitempty" displayname:"App name" doc-key:"importapp.name"`
+		KeepData bool            `json:"keepdata,omitempty" displayname:"Keep data" doc-key:"importapp.keepdata"`
+	}
+)
+
+const qvfContentType = "application/vnd.qlik.sense.app"
+
+// Validate ImportApp action (Implements ActionSettings interface)
+func (settings ImportAppSettings) Validate() ([]string, error) {
+	if settings.Filename == "" {
+		return nil, errors.Errorf("no filename defined for %s", ActionImportApp)
+	}
+	return nil, nil
+}
+
+// Execute ImportApp action (Implements ActionSettings interface)
+func (settings ImportAppSettings) Execute(sessionState *session.State, actionState *action.State, connection *connection.ConnectionSettings, label string, reset func()) {
+	name, err := settings.appName(sessionState)
+	if err != nil {
+		actionState.AddErrors(err)
+		return
+	}
+
+	// stream app file as request body, to not keep the whole file in memory for every user importing it
+	file, err := os.Open(settings.Filename)
+	if err != nil {
+		actionState.AddErrors(errors.Wrapf(err, "failed to open app file<%s>", settings.Filename))
+		return
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			sessionState.LogEntry.Logf(logger.WarningLevel, "failed to close app file<%s>: %v", settings.Filename, err)
+		}
+	}()
+	fileInfo, err := file.Stat()
+	if err != nil {
+		actionState.AddErrors(errors.Wrapf(err, "failed to stat app file<%s>", settings.Filename))
+		return
+	}
+
+	query := url.Values{}
+	query.Set("name", name)
+	query.Set("keepData", fmt.Sprintf("%t", settings.KeepData))
+	uploadUrl, err := qrsUrl(sessionState, connection, "app/upload", query)
+	if err != nil {
+		actionState.AddErrors(err)
+		return
+	}
+
+	options := qrsCreateOptions()
+	options.ContentType = qvfContentType
+	req, err := sessionState.Rest.PostReaderSync(uploadUrl, actionState, sessionState.LogEntry, file, fileInfo.Size(), options)
+	if err != nil {
+		return // failed request is already reported on action state
+	}
+	app, err := unmarshalQrsApp(req)
+	if err != nil {
+		actionState.AddErrors(errors.Wrapf(err, "failed to import app<%s>", settings.Filename))
+		return
+	}
+
+	addAppToArtifactMap(sessionState, app.Name, app.ID)
+	actionState.Details = fmt.Sprintf("%s;%s;%d", app.Name, app.ID, fileInfo.Size()) // log details in results as {App name};{App ID};{File size}
+}
+
+// appName name of imported app, defaults to filename without extension
+func (settings ImportAppSettings) appName(sessionState *session.State) (string, error) {
+	if settings.Name.String() == "" {
+		base := filepath.Base(settings.Filename)
+		return strings.TrimSuffix(base, filepath.Ext(base)), nil
+	}
+	name, err := sessionState.ReplaceSessionVariables(&settings.Name)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to expand app name")
+	}
+	return name, nil
+}
@@ -27,6 +27,8 @@ type (
 		Accept bool `json:"accept" displayname:"Accept selection" doc-key:"listboxselect.accept"`
 		// Wrap selection with Begin / End selection requests
 		Wrap bool `json:"wrap" displayname:"Wrap selection" doc-key:"listboxselect.wrap"`
+		// State alternate state listbox is expected to be bound to
+		State string `json:"state,omitempty" displayname:"Alternate state" doc-key:"listboxselect.state"`
 	}
 )
 
@@ -99,6 +101,15 @@ func (settings ListBoxSelectSettings) Execute(sessionState *session.State, actio
 		return
 	}
 
+	if err := verifyObjectState(obj, settings.State); err != nil {
+		actionState.AddErrors(errors.WithStack(err))
+		return
+	}
+	if err := trackStateSelections(sessionState, actionState, settings.State); err != nil {
+		actionState.AddErrors(errors.WithStack(err))
+		return
+	}
+
 	switch t := obj.EnigmaObject.(type) {
 	case *enigma.GenericObject:
 		genericObj := obj.EnigmaObject.(*enigma.GenericObject)
@@ -0,0 +1,104 @@
+package scenario
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/enigma-go/v4"
+	"github.com/qlik-oss/gopherciser/action"
+	"github.com/qlik-oss/gopherciser/connection"
+	"github.com/qlik-oss/gopherciser/senseobjects"
+	"github.com/qlik-oss/gopherciser/session"
+)
+
+type (
+	// LockAllSettings lock all selections
+	LockAllSettings struct {
+		State string `json:"state,omitempty" displayname:"Alternate state" doc-key:"lockall.state"`
+	}
+
+	// UnlockAllSettings unlock all selections
+	UnlockAllSettings struct {
+		State string `json:"state,omitempty" displayname:"Alternate state" doc-key:"unlockall.state"`
+	}
+
+	// LockFieldSettings lock selections in field
+	LockFieldSettings struct {
+		Name  string `json:"name" displayname:"Field name" doc-key:"lockfield.name"`
+		State string `json:"state,omitempty" displayname:"Alternate state" doc-key:"lockfield.state"`
+	}
+
+	// UnlockFieldSettings unlock selections in field
+	UnlockFieldSettings struct {
+		Name  string `json:"name" displayname:"Field name" doc-key:"unlockfield.name"`
+		State string `json:"state,omitempty" displayname:"Alternate state" doc-key:"unlockfield.state"`
+	}
+)
+
+// Validate LockAll action (Implements ActionSettings interface)
+func (settings LockAllSettings) Validate() ([]string, error) {
+	return nil, nil
+}
+
+// Execute LockAll action (Implements ActionSettings interface)
+func (settings LockAllSettings) Execute(sessionState *session.State, actionState *action.State, connection *connection.ConnectionSettings, label string, reset func()) {
+	executeAppSelectionRequest(sessionState, actionState, settings.State, "Failed to lock all selections", func(ctx context.Context, app *senseobjects.App) error {
+		return errors.WithStack(app.Doc.LockAll(ctx, settings.State))
+	})
+}
+
+// Validate UnlockAll action (Implements ActionSettings interface)
+func (settings UnlockAllSettings) Validate() ([]string, error) {
+	return nil, nil
+}
+
+// Execute UnlockAll action (Implements ActionSettings interface)
+func (settings UnlockAllSettings) Execute(sessionState *session.State, actionState *action.State, connection *connection.ConnectionSettings, label string, reset func()) {
+	executeAppSelectionRequest(sessionState, actionState, settings.State, "Failed to unlock all selections", func(ctx context.Context, app *senseobjects.App) error {
+		return errors.WithStack(app.Doc.UnlockAll(ctx, settings.State))
+	})
+}
+
+// Validate LockField action (Implements ActionSettings interface)
+func (settings LockFieldSettings) Validate() ([]string, error) {
+	if settings.Name == "" {
+		return nil, errors.Errorf("no name defined for %s action", ActionLockField)
+	}
+	return nil, nil
+}
+
+// Execute LockField action (Implements ActionSettings interface)
+func (settings LockFieldSettings) Execute(sessionState *session.State, actionState *action.State, connection *connection.ConnectionSettings, label string, reset func()) {
+	executeFieldSelectionRequest(sessionState, actionState, settings.Name, settings.State, "lock", (*enigma.Field).Lock)
+}
+
+// Validate UnlockField action (Implements ActionSettings interface)
+func (settings UnlockFieldSettings) Validate() ([]string, error) {
+	if settings.Name == "" {
+		return nil, errors.Errorf("no name defined for %s action", ActionUnlockField)
+	}
+	return nil, nil
+}
+
+// Execute UnlockField action (Implements ActionSettings interface)
+func (settings UnlockFieldSettings) Execute(sessionState *session.State, actionState *action.State, connection *connection.ConnectionSettings, label string, reset func()) {
+	executeFieldSelectionRequest(sessionState, actionState, settings.Name, settings.State, "unlock", (*enigma.Field).Unlock)
+}
+
+// executeFieldSelectionRequest get field in state and send request changing its selections
+func executeFieldSelectionRequest(sessionState *session.State, actionState *action.State, name, state, operation string, request func(field *enigma.Field, ctx context.Context) (bool, error)) {
+	executeAppSelectionRequest(sessionState, actionState, state, "", func(ctx context.Context, app *senseobjects.App) error {
+		field, err := app.Doc.GetField(ctx, name, state)
+		if err != nil {
+			return errors.Wrapf(err, "error getting field<%s>", name)
+		}
+		success, err := request(field, ctx)
+		if err != nil {
+			return errors.Wrapf(err, "error trying to %s field<%s>", operation, name)
+		}
+		if !success {
+			return errors.Errorf("failed to %s field<%s>", operation, name)
+		}
+		return nil
+	})
+}
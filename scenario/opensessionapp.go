@@ -0,0 +1,115 @@
+package scenario
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/enigma-go/v4"
+	"github.com/qlik-oss/gopherciser/action"
+	"github.com/qlik-oss/gopherciser/connection"
+	"github.com/qlik-oss/gopherciser/session"
+)
+
+type (
+	// OpenSessionAppSettings create session app, set script and reload it
+	OpenSessionAppSettings struct {
+		SourceApp  *session.AppSelection `json:"sourceapp,omitempty" displayname:"Source app" doc-key:"opensessionapp.sourceapp"`
+		Script     string                `json:"script,omitempty" displayname:"Script" displayelement:"textarea" doc-key:"opensessionapp.script"`
+		ReloadMode ReloadModeEnum        `json:"mode" displayname:"Reload mode" doc-key:"opensessionapp.mode"`
+		SaveLog    bool                  `json:"log" displayname:"Save log" doc-key:"opensessionapp.log"`
+	}
+)
+
+// sessionAppPrefix prefix of app id used when connecting to a session app
+const sessionAppPrefix = "SessionApp_"
+
+// Validate OpenSessionApp action (Implements ActionSettings interface)
+func (settings OpenSessionAppSettings) Validate() ([]string, error) {
+	if settings.SourceApp == nil {
+		if settings.Script == "" {
+			return nil, errors.Errorf("%s requires a script or a source app", ActionOpenSessionApp)
+		}
+		return nil, nil
+	}
+	if err := settings.SourceApp.Validate(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return nil, nil
+}
+
+// Execute OpenSessionApp action (Implements ActionSettings interface)
+func (settings OpenSessionAppSettings) Execute(sessionState *session.State, actionState *action.State, connectionSettings *connection.ConnectionSettings, label string, setOpenStart func()) {
+	actionState.FailOnDisconnect = true
+
+	var sourceAppID string
+	if settings.SourceApp != nil {
+		entry, err := settings.SourceApp.Select(sessionState)
+		if err != nil {
+			actionState.AddErrors(errors.Wrap(err, "Failed to perform app selection"))
+			return
+		}
+		sourceAppID = entry.ID
+	}
+
+	TrySetCSRFToken(sessionState, actionState, connectionSettings)
+
+	// session app is only available on the websocket connected to it
+	appID := sessionAppPrefix + uuid.NewString()
+	if err := connectEngineWs(sessionState, actionState, connectionSettings, label, appID); err != nil {
+		actionState.AddErrors(errors.WithStack(err))
+		return
+	}
+
+	sessionState.Counters.StatisticsCollector.IncOpenedApps()
+	setOpenStart()
+
+	sessionState.LogEntry.Session.AppName = appID
+	sessionState.LogEntry.Session.AppGUID = appID
+	actionState.Details = appID
+	if sourceAppID != "" {
+		actionState.Details = fmt.Sprintf("%s;%s", appID, sourceAppID) // log details in results as {Session app ID};{Source app ID}
+	}
+
+	uplink := sessionState.Connection.Sense()
+	if err := sessionState.SendRequest(actionState, func(ctx context.Context) error {
+		var doc *enigma.Doc
+		var err error
+		if sourceAppID == "" {
+			doc, err = uplink.Global.CreateSessionApp(ctx)
+		} else {
+			doc, err = uplink.Global.CreateSessionAppFromApp(ctx, sourceAppID)
+		}
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		return errors.WithStack(uplink.SetCurrentApp(appID, doc))
+	}); err != nil {
+		actionState.AddErrors(errors.Wrap(err, "Failed to create session app"))
+		return
+	}
+	doc := uplink.CurrentApp.Doc
+
+	if settings.Script != "" {
+		if err := setScript(sessionState, actionState, doc, settings.Script); err != nil {
+			actionState.AddErrors(err)
+			return
+		}
+	}
+
+	// session apps can't be saved
+	reload := ReloadSettings{
+		ReloadMode: settings.ReloadMode,
+		SaveLog:    settings.SaveLog,
+		NoSave:     true,
+	}
+	if err := reload.DoReload(sessionState.BaseContext(), sessionState, actionState, uplink, doc); err != nil {
+		actionState.AddErrors(errors.WithStack(err))
+		return
+	}
+
+	DoPostOpenAppRequests(sessionState, actionState, uplink, appID)
+
+	sessionState.Wait(actionState)
+}
@@ -0,0 +1,40 @@
+package scenario
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/qlik-oss/gopherciser/session"
+)
+
+func TestOpenSessionAppSettingsUnmarshal(t *testing.T) {
+	raw := `{
+		"sourceapp": {
+			"appmode": "name",
+			"app": "Sales"
+		},
+		"mode": "abend",
+		"log": true
+	}`
+
+	var settings OpenSessionAppSettings
+	if err := json.Unmarshal([]byte(raw), &settings); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := settings.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if settings.SourceApp == nil || settings.SourceApp.AppMode != session.AppModeName || settings.SourceApp.App.String() != "Sales" {
+		t.Errorf("unexpected source app<%+v>", settings.SourceApp)
+	}
+	if settings.ReloadMode != Abend || !settings.SaveLog {
+		t.Errorf("unexpected reload settings<%+v>", settings)
+	}
+
+	if _, err := (OpenSessionAppSettings{}).Validate(); err == nil {
+		t.Error("expected validation error for session app without script or source app")
+	}
+	if _, err := (OpenSessionAppSettings{Script: "Load 1 as A autogenerate 1;"}).Validate(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,291 @@
+package scenario
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/enigma-go/v4"
+	"github.com/qlik-oss/gopherciser/action"
+	"github.com/qlik-oss/gopherciser/connection"
+	"github.com/qlik-oss/gopherciser/enigmahandlers"
+	"github.com/qlik-oss/gopherciser/enummap"
+	"github.com/qlik-oss/gopherciser/globals/constant"
+	"github.com/qlik-oss/gopherciser/helpers"
+	"github.com/qlik-oss/gopherciser/logger"
+	"github.com/qlik-oss/gopherciser/session"
+)
+
+type (
+	// PivotOperation expand or collapse operation on pivot table node
+	PivotOperation int
+	// TargetType how to choose target of action
+	TargetType int
+
+	// PivotSettings expand or collapse node of a pivot table
+	PivotSettings struct {
+		ID        string         `json:"id" displayname:"Object ID" doc-key:"pivot.id"`
+		Operation PivotOperation `json:"operation" displayname:"Operation" doc-key:"pivot.operation"`
+		Target    TargetType     `json:"target" displayname:"Target" doc-key:"pivot.target"`
+		Row       int            `json:"row,omitempty" displayname:"Row" doc-key:"pivot.row"`
+		Column    int            `json:"column,omitempty" displayname:"Column" doc-key:"pivot.column"`
+		All       bool           `json:"all,omitempty" displayname:"All nodes" doc-key:"pivot.all"`
+	}
+
+	// pivotNode position of a node in left or top dimension tree
+	pivotNode struct {
+		Row    int
+		Column int
+	}
+)
+
+// PivotOperation enum
+const (
+	PivotExpandLeft PivotOperation = iota
+	PivotExpandTop
+	PivotCollapseLeft
+	PivotCollapseTop
+)
+
+// TargetType enum
+const (
+	TargetRandom TargetType = iota
+	TargetIndex
+)
+
+var (
+	pivotOperationEnumMap = enummap.NewEnumMapOrPanic(map[string]int{
+		"expandleft":   int(PivotExpandLeft),
+		"expandtop":    int(PivotExpandTop),
+		"collapseleft": int(PivotCollapseLeft),
+		"collapsetop":  int(PivotCollapseTop),
+	})
+
+	targetTypeEnumMap = enummap.NewEnumMapOrPanic(map[string]int{
+		"random": int(TargetRandom),
+		"index":  int(TargetIndex),
+	})
+)
+
+// GetEnumMap returns pivot operation enum map to GUI
+func (value PivotOperation) GetEnumMap() *enummap.EnumMap {
+	return pivotOperationEnumMap
+}
+
+// UnmarshalJSON unmarshal PivotOperation
+func (value *PivotOperation) UnmarshalJSON(arg []byte) error {
+	i, err := value.GetEnumMap().UnMarshal(arg)
+	if err != nil {
+		return errors.Wrap(err, "Failed to unmarshal PivotOperation")
+	}
+
+	*value = PivotOperation(i)
+	return nil
+}
+
+// MarshalJSON marshal PivotOperation
+func (value PivotOperation) MarshalJSON() ([]byte, error) {
+	str, err := value.GetEnumMap().String(int(value))
+	if err != nil {
+		return nil, errors.Errorf("Unknown PivotOperation<%d>", value)
+	}
+	return []byte(fmt.Sprintf(`"%s"`, str)), nil
+}
+
+// String representation of PivotOperation
+func (value PivotOperation) String() string {
+	return value.GetEnumMap().StringDefault(int(value), strconv.Itoa(int(value)))
+}
+
+// left returns true if operation is on left dimension tree
+func (value PivotOperation) left() bool {
+	return value == PivotExpandLeft || value == PivotCollapseLeft
+}
+
+// expand returns true if operation expands nodes
+func (value PivotOperation) expand() bool {
+	return value == PivotExpandLeft || value == PivotExpandTop
+}
+
+// GetEnumMap returns target type enum map to GUI
+func (value TargetType) GetEnumMap() *enummap.EnumMap {
+	return targetTypeEnumMap
+}
+
+// UnmarshalJSON unmarshal TargetType
+func (value *TargetType) UnmarshalJSON(arg []byte) error {
+	i, err := value.GetEnumMap().UnMarshal(arg)
+	if err != nil {
+		return errors.Wrap(err, "Failed to unmarshal TargetType")
+	}
+
+	*value = TargetType(i)
+	return nil
+}
+
+// MarshalJSON marshal TargetType
+func (value TargetType) MarshalJSON() ([]byte, error) {
+	str, err := value.GetEnumMap().String(int(value))
+	if err != nil {
+		return nil, errors.Errorf("Unknown TargetType<%d>", value)
+	}
+	return []byte(fmt.Sprintf(`"%s"`, str)), nil
+}
+
+// String representation of TargetType
+func (value TargetType) String() string {
+	return value.GetEnumMap().StringDefault(int(value), strconv.Itoa(int(value)))
+}
+
+// Validate PivotSettings action (Implements ActionSettings interface)
+func (settings PivotSettings) Validate() ([]string, error) {
+	if settings.ID == "" {
+		return nil, errors.Errorf("no object id defined for %s", ActionPivot)
+	}
+	if _, err := pivotOperationEnumMap.String(int(settings.Operation)); err != nil {
+		return nil, errors.Errorf("unknown pivot operation<%d>", settings.Operation)
+	}
+	if _, err := targetTypeEnumMap.String(int(settings.Target)); err != nil {
+		return nil, errors.Errorf("unknown target<%d>", settings.Target)
+	}
+	if settings.Row < 0 || settings.Column < 0 {
+		return nil, errors.Errorf("illegal node row<%d> column<%d>", settings.Row, settings.Column)
+	}
+	return nil, nil
+}
+
+// Execute PivotSettings action (Implements ActionSettings interface)
+func (settings PivotSettings) Execute(sessionState *session.State, actionState *action.State, connection *connection.ConnectionSettings, label string, reset func()) {
+	if sessionState.Connection == nil || sessionState.Connection.Sense() == nil {
+		actionState.AddErrors(errors.New("Not connected to a Sense environment"))
+		return
+	}
+
+	// make sure object has latest data before choosing node
+	if sessionState.Wait(actionState) {
+		return
+	}
+
+	uplink := sessionState.Connection.Sense()
+	objectID := sessionState.IDMap.Get(settings.ID)
+	obj, err := uplink.Objects.GetObjectByID(objectID)
+	if err != nil {
+		actionState.AddErrors(errors.Wrapf(err, "Failed getting object<%s> from object list", objectID))
+		return
+	}
+
+	gob, ok := obj.EnigmaObject.(*enigma.GenericObject)
+	if !ok {
+		actionState.AddErrors(errors.Errorf("Failed to cast object<%s> to *enigma.GenericObject", objectID))
+		return
+	}
+
+	hypercube := obj.HyperCube()
+	if hypercube == nil || hypercube.HyperCube == nil {
+		actionState.AddErrors(errors.Errorf("object<%s> has no hypercube", objectID))
+		return
+	}
+	if hypercube.Mode != constant.HyperCubeDataModePivot && hypercube.Mode != constant.HyperCubeDataModePivotL {
+		actionState.AddErrors(errors.Errorf("object<%s> hypercube mode<%s> is not pivot", objectID, hypercube.Mode))
+		return
+	}
+
+	node := pivotNode{Row: settings.Row, Column: settings.Column}
+	if settings.Target == TargetRandom {
+		nodes := pivotNodes(obj.HyperPivotPages(), settings.Operation)
+		if len(nodes) < 1 {
+			sessionState.LogEntry.Logf(logger.WarningLevel, "object<%s> has no nodes to %s", objectID, settings.Operation)
+			return
+		}
+		node = nodes[sessionState.Randomizer().Rand(len(nodes))]
+	}
+
+	actionState.Details = fmt.Sprintf("%s;%s;%d;%d", objectID, settings.Operation, node.Row, node.Column) // log details in results as {Object ID};{Operation};{Row};{Column}
+
+	path, err := hyperCubeDefPath(gob)
+	if err != nil {
+		actionState.AddErrors(errors.WithStack(err))
+		return
+	}
+
+	err = sessionState.SendRequest(actionState, func(ctx context.Context) error {
+		switch settings.Operation {
+		case PivotExpandLeft:
+			return gob.ExpandLeft(ctx, path, node.Row, node.Column, settings.All)
+		case PivotExpandTop:
+			return gob.ExpandTop(ctx, path, node.Row, node.Column, settings.All)
+		case PivotCollapseLeft:
+			return gob.CollapseLeft(ctx, path, node.Row, node.Column, settings.All)
+		case PivotCollapseTop:
+			return gob.CollapseTop(ctx, path, node.Row, node.Column, settings.All)
+		default:
+			return errors.Errorf("unknown pivot operation<%s>", settings.Operation)
+		}
+	})
+	if err != nil {
+		actionState.AddErrors(errors.Wrapf(err, "object<%s> %s failed", objectID, settings.Operation))
+		return
+	}
+
+	// await object layout update before fetching resulting data page
+	if sessionState.Wait(actionState) {
+		return
+	}
+
+	if err := fetchPivotFirstPage(sessionState, actionState, gob, obj); err != nil {
+		actionState.AddErrors(errors.WithStack(err))
+	}
+}
+
+// fetchPivotFirstPage fetches the first page of a pivot object, as seen by a user after expand or collapse
+func fetchPivotFirstPage(sessionState *session.State, actionState *action.State, gob *enigma.GenericObject, obj *enigmahandlers.Object) error {
+	hypercube := obj.HyperCube()
+	if hypercube == nil || hypercube.Size == nil {
+		return errors.Errorf("object<%s> has no hypercube size", gob.GenericId)
+	}
+	if hypercube.Size.Cx < 1 || hypercube.Size.Cy < 1 {
+		return errors.WithStack(obj.SetPivotHyperCubePages(make([]*enigma.NxPivotPage, 0)))
+	}
+	page := newScrollPage(ScrollVertical, 0, hypercube.Size.Cx, nil)
+	return errors.WithStack(fetchHyperCubePage(sessionState, actionState, gob, obj, true, page.NxPage))
+}
+
+// pivotNodes lists nodes of pivot pages which can be expanded or collapsed by operation
+func pivotNodes(pages []*enigma.NxPivotPage, operation PivotOperation) []pivotNode {
+	var nodes []pivotNode
+	for _, page := range pages {
+		if page == nil {
+			continue
+		}
+		top, left := 0, 0
+		if page.Area != nil {
+			top, left = page.Area.Top, page.Area.Left
+		}
+		if operation.left() {
+			collectPivotNodes(page.Left, 0, top, operation.expand(), func(pos, depth int) {
+				nodes = append(nodes, pivotNode{Row: pos, Column: depth})
+			})
+		} else {
+			collectPivotNodes(page.Top, 0, left, operation.expand(), func(pos, depth int) {
+				nodes = append(nodes, pivotNode{Row: depth, Column: pos})
+			})
+		}
+	}
+	return nodes
+}
+
+// collectPivotNodes walks dimension tree calling add for nodes possible to expand or collapse, returns span of cells
+func collectPivotNodes(cells []*enigma.NxPivotDimensionCell, depth, pos int, expand bool, add func(pos, depth int)) int {
+	span := 0
+	for _, cell := range cells {
+		if cell == nil {
+			continue
+		}
+		if (expand && cell.CanExpand) || (!expand && cell.CanCollapse) {
+			add(pos+span, depth)
+		}
+		span += helpers.Max(1, collectPivotNodes(cell.SubNodes, depth+1, pos+span, expand, add))
+	}
+	return span
+}
@@ -0,0 +1,85 @@
+package scenario
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/qlik-oss/enigma-go/v4"
+)
+
+func TestPivotSettingsUnmarshal(t *testing.T) {
+	raw := `{
+		"id": "pivot1",
+		"operation": "collapsetop",
+		"target": "index",
+		"row": 1,
+		"column": 2
+	}`
+
+	var settings PivotSettings
+	if err := json.Unmarshal([]byte(raw), &settings); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := settings.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	expected := PivotSettings{ID: "pivot1", Operation: PivotCollapseTop, Target: TargetIndex, Row: 1, Column: 2}
+	if settings != expected {
+		t.Errorf("unexpected settings<%+v> expected<%+v>", settings, expected)
+	}
+
+	invalid := []PivotSettings{
+		{},
+		{ID: "pivot1", Operation: PivotOperation(7)},
+		{ID: "pivot1", Target: TargetType(3)},
+		{ID: "pivot1", Row: -1},
+	}
+	for _, settings := range invalid {
+		if _, err := settings.Validate(); err == nil {
+			t.Errorf("expected validation error for<%+v>", settings)
+		}
+	}
+}
+
+func TestPivotNodes(t *testing.T) {
+	// left tree:
+	// Sweden (expanded) - Stockholm
+	//                   - Uppsala
+	// Norway (collapsed)
+	// Totals
+	pages := []*enigma.NxPivotPage{{
+		Area: &enigma.Rect{Top: 10, Left: 0, Width: 2, Height: 4},
+		Left: []*enigma.NxPivotDimensionCell{
+			{Text: "Sweden", CanCollapse: true, SubNodes: []*enigma.NxPivotDimensionCell{
+				{Text: "Stockholm"},
+				{Text: "Uppsala"},
+			}},
+			{Text: "Norway", CanExpand: true},
+			{Text: "Totals", Type: "T"},
+		},
+		Top: []*enigma.NxPivotDimensionCell{
+			{Text: "2020", CanExpand: true},
+			{Text: "2021", CanCollapse: true, SubNodes: []*enigma.NxPivotDimensionCell{
+				{Text: "Q1", CanExpand: true},
+				{Text: "Q2", CanExpand: true},
+			}},
+		},
+	}}
+
+	tests := []struct {
+		operation PivotOperation
+		expected  []pivotNode
+	}{
+		{PivotExpandLeft, []pivotNode{{Row: 12, Column: 0}}},
+		{PivotCollapseLeft, []pivotNode{{Row: 10, Column: 0}}},
+		{PivotExpandTop, []pivotNode{{Row: 0, Column: 0}, {Row: 1, Column: 1}, {Row: 1, Column: 2}}},
+		{PivotCollapseTop, []pivotNode{{Row: 0, Column: 1}}},
+	}
+
+	for _, test := range tests {
+		if nodes := pivotNodes(pages, test.operation); !reflect.DeepEqual(nodes, test.expected) {
+			t.Errorf("operation<%s> unexpected nodes<%+v> expected<%+v>", test.operation, nodes, test.expected)
+		}
+	}
+}
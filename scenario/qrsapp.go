@@ -0,0 +1,70 @@
+package scenario
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/goccy/go-json"
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/gopherciser/connection"
+	"github.com/qlik-oss/gopherciser/session"
+	"github.com/qlik-oss/gopherciser/structs"
+)
+
+// qrsUrl creates url towards QRS endpoint with xrfkey added to query
+func qrsUrl(sessionState *session.State, connectionSettings *connection.ConnectionSettings, endpoint string, query url.Values) (string, error) {
+	host, err := connectionSettings.RestUrl()
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	xrfkey, err := sessionState.GetXrfKey(host)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	if query == nil {
+		query = make(url.Values, 1)
+	}
+	query.Set("xrfkey", xrfkey)
+	return fmt.Sprintf("%s/qrs/%s?%s", host, endpoint, query.Encode()), nil
+}
+
+// qrsCreateOptions request options for QRS endpoints creating a resource
+func qrsCreateOptions() *session.ReqOptions {
+	options := session.DefaultReqOptions()
+	options.ExpectedStatusCode = []int{http.StatusOK, http.StatusCreated}
+	return options
+}
+
+// unmarshalQrsApp unmarshal app from QRS response
+func unmarshalQrsApp(req *session.RestRequest) (*structs.QrsApp, error) {
+	var app structs.QrsApp
+	if err := json.Unmarshal(req.ResponseBody, &app); err != nil {
+		return nil, errors.Wrapf(err, "failed unmarshaling app response: %s", req.ResponseBody)
+	}
+	if app.ID == "" {
+		return nil, errors.Errorf("no app id in response: %s", req.ResponseBody)
+	}
+	return &app, nil
+}
+
+// addAppToArtifactMap adds new app to artifact map and sets it as current app, to be targeted by subsequent actions
+func addAppToArtifactMap(sessionState *session.State, name, id string) {
+	entry := &session.ArtifactEntry{
+		Name:         name,
+		ID:           id,
+		ResourceType: session.ResourceTypeApp,
+	}
+	sessionState.ArtifactMap.Append(session.ResourceTypeApp, entry)
+	sessionState.CurrentApp = entry.Copy()
+	sessionState.LogEntry.Session.AppName = name
+	sessionState.LogEntry.Session.AppGUID = id
+}
+
+// removeAppFromArtifactMap removes deleted app from artifact map and as current app
+func removeAppFromArtifactMap(sessionState *session.State, id string) {
+	sessionState.ArtifactMap.DeleteApp(id)
+	if sessionState.CurrentApp != nil && sessionState.CurrentApp.ID == id {
+		sessionState.CurrentApp = nil
+	}
+}
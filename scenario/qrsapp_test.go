@@ -0,0 +1,93 @@
+package scenario
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/qlik-oss/gopherciser/logger"
+	"github.com/qlik-oss/gopherciser/session"
+	"github.com/qlik-oss/gopherciser/statistics"
+)
+
+func TestDuplicateAppSettingsUnmarshal(t *testing.T) {
+	raw := `{
+		"appmode": "name",
+		"app": "Sales",
+		"name": "Sales copy"
+	}`
+
+	var settings DuplicateAppSettings
+	if err := json.Unmarshal([]byte(raw), &settings); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := settings.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if settings.AppMode != session.AppModeName || settings.App.String() != "Sales" {
+		t.Errorf("unexpected app selection<%+v>", settings.AppSelection)
+	}
+	if settings.Name.String() != "Sales copy" {
+		t.Errorf("unexpected name<%s>", settings.Name.String())
+	}
+}
+
+func TestExportAppSettingsUnmarshal(t *testing.T) {
+	var settings ExportAppSettings
+	if err := json.Unmarshal([]byte(`{"appmode": "current", "nodata": true}`), &settings); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := settings.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if settings.AppMode != session.AppModeCurrent || !settings.NoData {
+		t.Errorf("unexpected settings<%+v>", settings)
+	}
+}
+
+func TestImportAppName(t *testing.T) {
+	settings := ImportAppSettings{Filename: "/path/to/my app.qvf"}
+	if _, err := settings.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	name, err := settings.appName(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "my app" {
+		t.Errorf("unexpected default app name<%s>", name)
+	}
+
+	if _, err := (ImportAppSettings{}).Validate(); err == nil {
+		t.Error("expected validation error for missing filename")
+	}
+}
+
+func TestArtifactMapAppLifecycle(t *testing.T) {
+	sessionState := session.New(context.Background(), "", time.Second, nil, 1, 1, "", false, &statistics.ExecutionCounters{})
+	defer sessionState.Disconnect()
+	sessionState.LogEntry = logger.NewLogEntry(&logger.Log{})
+	sessionState.LogEntry.Session = &logger.SessionEntry{}
+
+	addAppToArtifactMap(sessionState, "new app", "guid1")
+
+	entry, err := sessionState.ArtifactMap.LookupAppTitle("new app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry.ID != "guid1" || sessionState.CurrentApp == nil || sessionState.CurrentApp.ID != "guid1" {
+		t.Errorf("unexpected app entry<%+v> current app<%+v>", entry, sessionState.CurrentApp)
+	}
+
+	removeAppFromArtifactMap(sessionState, "guid1")
+	if _, err := sessionState.ArtifactMap.LookupAppTitle("new app"); err == nil {
+		t.Error("expected app to be removed from artifact map")
+	}
+	if sessionState.CurrentApp != nil {
+		t.Error("expected current app to be cleared")
+	}
+	if sessionState.LogEntry.Session.AppGUID != "guid1" {
+		t.Errorf("unexpected app guid<%s> in log entry", sessionState.LogEntry.Session.AppGUID)
+	}
+}
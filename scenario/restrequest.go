@@ -0,0 +1,124 @@
+package scenario
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/gopherciser/action"
+	"github.com/qlik-oss/gopherciser/connection"
+	"github.com/qlik-oss/gopherciser/session"
+	"github.com/qlik-oss/gopherciser/synced"
+)
+
+type (
+	// RestRequestHeader header added to REST request
+	RestRequestHeader struct {
+		Name  string          `json:"name" displayname:"Name" doc-key:"restrequest.headers.name"`
+		Value synced.Template `json:"value" displayname:"Value" doc-key:"restrequest.headers.value"`
+	}
+
+	// RestRequestSettings send generic REST request and extract values from response into script variables
+	RestRequestSettings struct {
+		Method         session.RestMethod  `json:"method" displayname:"Method" doc-key:"restrequest.method"`
+		URL            synced.Template     `json:"url" displayname:"URL" doc-key:"restrequest.url"`
+		Headers        []RestRequestHeader `json:"headers,omitempty" displayname:"Headers" doc-key:"restrequest.headers"`
+		Body           synced.Template     `json:"body,omitempty" displayname:"Body" doc-key:"restrequest.body" displayelement:"textarea"`
+		ContentType    string              `json:"contenttype,omitempty" displayname:"Content-Type" doc-key:"restrequest.contenttype"`
+		ExpectedStatus []int               `json:"expectedstatus,omitempty" displayname:"Expected status codes" doc-key:"restrequest.expectedstatus"`
+		Extractors     []session.Extractor `json:"extractors,omitempty" displayname:"Extractors" doc-key:"restrequest.extractors"`
+	}
+)
+
+// defaultRestRequestStatus expected status codes when none are defined
+var defaultRestRequestStatus = []int{http.StatusOK, http.StatusCreated}
+
+// Validate RestRequest action (Implements ActionSettings interface)
+func (settings RestRequestSettings) Validate() ([]string, error) {
+	if settings.URL.String() == "" {
+		return nil, errors.Errorf("no url defined for %s", ActionRestRequest)
+	}
+	if _, err := settings.Method.GetEnumMap().String(int(settings.Method)); err != nil {
+		return nil, errors.Errorf("unknown method<%d>", settings.Method)
+	}
+	for _, header := range settings.Headers {
+		if header.Name == "" {
+			return nil, errors.New("header defined without name")
+		}
+	}
+	for i := range settings.Extractors {
+		if err := settings.Extractors[i].Validate(); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+	return nil, nil
+}
+
+// Execute RestRequest action (Implements ActionSettings interface)
+func (settings RestRequestSettings) Execute(sessionState *session.State, actionState *action.State, connection *connection.ConnectionSettings, label string, reset func()) {
+	url, err := settings.url(sessionState, connection)
+	if err != nil {
+		actionState.AddErrors(err)
+		return
+	}
+
+	headers := make(map[string]string, len(settings.Headers))
+	for i := range settings.Headers {
+		value, err := sessionState.ReplaceSessionVariables(&settings.Headers[i].Value)
+		if err != nil {
+			actionState.AddErrors(errors.Wrapf(err, "failed to expand header<%s>", settings.Headers[i].Name))
+			return
+		}
+		headers[settings.Headers[i].Name] = value
+	}
+
+	var content []byte
+	if settings.Body.String() != "" {
+		body, err := sessionState.ReplaceSessionVariables(&settings.Body)
+		if err != nil {
+			actionState.AddErrors(errors.Wrap(err, "failed to expand body"))
+			return
+		}
+		content = []byte(body)
+	}
+
+	expectedStatus := settings.ExpectedStatus
+	if len(expectedStatus) < 1 {
+		expectedStatus = defaultRestRequestStatus
+	}
+	options := &session.ReqOptions{
+		ExpectedStatusCode: expectedStatus,
+		FailOnError:        true,
+		ContentType:        settings.ContentType,
+	}
+
+	actionState.Details = fmt.Sprintf("%s;%s", settings.Method, url) // log details in results as {Method};{URL}
+
+	req, err := sessionState.Rest.SendSync(settings.Method, url, actionState, sessionState.LogEntry, content, headers, options)
+	if err != nil {
+		return // failed request, or unexpected status code, is already reported on action state
+	}
+
+	if err := session.ExtractAndValidate(settings.Extractors, req.ResponseBody, sessionState.LogEntry, func(name, value string) {
+		sessionState.SetVariableValue(name, value)
+	}); err != nil {
+		actionState.AddErrors(errors.Wrapf(err, "%s request<%s> failed", settings.Method, url))
+	}
+}
+
+// url of request, an url starting with "/" is relative to the REST URL of the connection
+func (settings RestRequestSettings) url(sessionState *session.State, connection *connection.ConnectionSettings) (string, error) {
+	path, err := sessionState.ReplaceSessionVariables(&settings.URL)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to expand url")
+	}
+	if !strings.HasPrefix(path, "/") {
+		return path, nil
+	}
+	restUrl, err := connection.RestUrl()
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return strings.TrimSuffix(restUrl, "/") + path, nil
+}
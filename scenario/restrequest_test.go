@@ -0,0 +1,46 @@
+package scenario
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/qlik-oss/gopherciser/session"
+)
+
+func TestRestRequestSettingsUnmarshal(t *testing.T) {
+	raw := `{
+		"method": "POST",
+		"url": "/api/v1/reports",
+		"headers": [{ "name": "X-Test", "value": "{{.UserName}}" }],
+		"body": "{\"type\": \"sense-sheet-1.0\"}",
+		"expectedstatus": [201, 202],
+		"extractors": [{ "name": "reportid", "path": "/id" }]
+	}`
+
+	var settings RestRequestSettings
+	if err := json.Unmarshal([]byte(raw), &settings); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := settings.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if settings.Method != session.POST || settings.URL.String() != "/api/v1/reports" || len(settings.Headers) != 1 ||
+		len(settings.ExpectedStatus) != 2 || len(settings.Extractors) != 1 || settings.Extractors[0].Name != "reportid" {
+		t.Errorf("unexpected settings<%+v>", settings)
+	}
+
+	invalid := []string{
+		`{ "method": "get" }`,
+		`{ "method": "get", "url": "/api/v1/items", "headers": [{ "value": "a" }] }`,
+		`{ "method": "get", "url": "/api/v1/items", "extractors": [{ "name": "id" }] }`,
+	}
+	for _, raw := range invalid {
+		var settings RestRequestSettings
+		if err := json.Unmarshal([]byte(raw), &settings); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := settings.Validate(); err == nil {
+			t.Errorf("expected validation error for<%s>", raw)
+		}
+	}
+}
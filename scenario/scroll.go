@@ -0,0 +1,317 @@
+package scenario
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/enigma-go/v4"
+	"github.com/qlik-oss/gopherciser/action"
+	"github.com/qlik-oss/gopherciser/connection"
+	"github.com/qlik-oss/gopherciser/enigmahandlers"
+	"github.com/qlik-oss/gopherciser/enummap"
+	"github.com/qlik-oss/gopherciser/globals/constant"
+	"github.com/qlik-oss/gopherciser/helpers"
+	"github.com/qlik-oss/gopherciser/senseobjdef"
+	"github.com/qlik-oss/gopherciser/session"
+)
+
+type (
+	// ScrollDirection direction to scroll object data
+	ScrollDirection int
+
+	// ScrollSettings scroll through data of a table or pivot table
+	ScrollSettings struct {
+		ID        string             `json:"id" displayname:"Object ID" doc-key:"scroll.id"`
+		Direction ScrollDirection    `json:"direction" displayname:"Scroll direction" doc-key:"scroll.direction"`
+		PageSize  int                `json:"pagesize,omitempty" displayname:"Page size" doc-key:"scroll.pagesize"`
+		Pages     int                `json:"pages" displayname:"Pages" doc-key:"scroll.pages"`
+		ThinkTime *ThinkTimeSettings `json:"thinktime,omitempty" displayname:"Think time settings" doc-key:"scroll.thinktime"`
+	}
+
+	// scrollPage area of data to fetch
+	scrollPage struct {
+		enigma.NxPage
+	}
+)
+
+// ScrollDirection enum
+const (
+	ScrollVertical ScrollDirection = iota
+	ScrollHorizontal
+)
+
+const (
+	// defaultScrollPageHeight rows fetched per page, same as initial height of table
+	defaultScrollPageHeight = 40
+	// defaultScrollPageWidth columns fetched per page when scrolling horizontally
+	defaultScrollPageWidth = 10
+	// maxScrollPageCells engine limit of cells in one data page
+	maxScrollPageCells = 10000
+)
+
+var (
+	scrollDirectionEnumMap = enummap.NewEnumMapOrPanic(map[string]int{
+		"vertical":   int(ScrollVertical),
+		"horizontal": int(ScrollHorizontal),
+	})
+
+	// scrollObjectTypes object types supported by scroll action
+	scrollObjectTypes = map[string]struct{}{
+		"table":          {},
+		"sn-table":       {},
+		"pivot-table":    {},
+		"sn-pivot-table": {},
+	}
+
+	scrollDefaultThinkTimeSettings = ThinkTimeSettings{
+		DistributionSettings: helpers.DistributionSettings{
+			Type:      helpers.UniformDistribution,
+			Mean:      float64(1),
+			Deviation: 0.5,
+		},
+	}
+)
+
+// GetEnumMap returns scroll direction enum map to GUI
+func (value ScrollDirection) GetEnumMap() *enummap.EnumMap {
+	return scrollDirectionEnumMap
+}
+
+// UnmarshalJSON unmarshal ScrollDirection
+func (value *ScrollDirection) UnmarshalJSON(arg []byte) error {
+	i, err := value.GetEnumMap().UnMarshal(arg)
+	if err != nil {
+		return errors.Wrap(err, "Failed to unmarshal ScrollDirection")
+	}
+
+	*value = ScrollDirection(i)
+	return nil
+}
+
+// MarshalJSON marshal ScrollDirection
+func (value ScrollDirection) MarshalJSON() ([]byte, error) {
+	str, err := value.GetEnumMap().String(int(value))
+	if err != nil {
+		return nil, errors.Errorf("Unknown ScrollDirection<%d>", value)
+	}
+	return []byte(fmt.Sprintf(`"%s"`, str)), nil
+}
+
+// String representation of ScrollDirection
+func (value ScrollDirection) String() string {
+	return value.GetEnumMap().StringDefault(int(value), strconv.Itoa(int(value)))
+}
+
+// Validate ScrollSettings action (Implements ActionSettings interface)
+func (settings ScrollSettings) Validate() ([]string, error) {
+	if settings.ID == "" {
+		return nil, errors.Errorf("no object id defined for %s", ActionScroll)
+	}
+	if _, err := scrollDirectionEnumMap.String(int(settings.Direction)); err != nil {
+		return nil, errors.Errorf("unknown scroll direction<%d>", settings.Direction)
+	}
+	if settings.PageSize < 0 {
+		return nil, errors.Errorf("illegal page size<%d>", settings.PageSize)
+	}
+	if settings.Pages < 1 {
+		return nil, errors.Errorf("pages<%d> needs to be 1 or more", settings.Pages)
+	}
+	if settings.ThinkTime != nil {
+		warnings, err := settings.ThinkTime.Validate()
+		if err != nil {
+			return warnings, errors.Wrap(err, "invalid think time settings")
+		}
+		return warnings, nil
+	}
+	return nil, nil
+}
+
+// Execute ScrollSettings action (Implements ActionSettings interface)
+func (settings ScrollSettings) Execute(sessionState *session.State, actionState *action.State, connection *connection.ConnectionSettings, label string, reset func()) {
+	if sessionState.Connection == nil || sessionState.Connection.Sense() == nil {
+		actionState.AddErrors(errors.New("Not connected to a Sense environment"))
+		return
+	}
+
+	// make sure pending object updates are done before scrolling from current position
+	if sessionState.Wait(actionState) {
+		return
+	}
+
+	uplink := sessionState.Connection.Sense()
+	objectID := sessionState.IDMap.Get(settings.ID)
+	obj, err := uplink.Objects.GetObjectByID(objectID)
+	if err != nil {
+		actionState.AddErrors(errors.Wrapf(err, "Failed getting object<%s> from object list", objectID))
+		return
+	}
+
+	gob, ok := obj.EnigmaObject.(*enigma.GenericObject)
+	if !ok {
+		actionState.AddErrors(errors.Errorf("Failed to cast object<%s> to *enigma.GenericObject", objectID))
+		return
+	}
+	if _, ok := scrollObjectTypes[gob.GenericType]; !ok {
+		actionState.AddErrors(errors.Errorf("object<%s> type<%s> not supported by %s", objectID, gob.GenericType, ActionScroll))
+		return
+	}
+
+	hypercube := obj.HyperCube()
+	if hypercube == nil || hypercube.HyperCube == nil {
+		actionState.AddErrors(errors.Errorf("object<%s> has no hypercube", objectID))
+		return
+	}
+	if hypercube.Error != nil {
+		actionState.AddErrors(errors.Errorf("object<%s> hypercube has error<%s>", objectID, session.EngineCodeToString(hypercube.Error.ErrorCode)))
+		return
+	}
+	if hypercube.Size == nil {
+		actionState.AddErrors(errors.Errorf("object<%s> has no hypercube size", objectID))
+		return
+	}
+
+	pivot := hypercube.Mode == constant.HyperCubeDataModePivot || hypercube.Mode == constant.HyperCubeDataModePivotL
+	page := settings.firstPage(obj, pivot)
+
+	thinkTime := settings.ThinkTime
+	if thinkTime == nil {
+		thinkTime = &scrollDefaultThinkTimeSettings
+	}
+
+	fetched := 0
+	for ; fetched < settings.Pages; fetched++ {
+		if !page.inside(hypercube.Size) {
+			sessionState.LogEntry.LogDebugf("object<%s> scrolled to end of data after %d pages", objectID, fetched)
+			break
+		}
+
+		if fetched > 0 {
+			if err := executeThinkTimeSubAction(sessionState, connection, fmt.Sprintf("thinktime before: %s", label), thinkTime); err != nil {
+				actionState.AddErrors(errors.WithStack(err))
+				return
+			}
+		}
+
+		if err := fetchHyperCubePage(sessionState, actionState, gob, obj, pivot, page.NxPage); err != nil {
+			actionState.AddErrors(errors.WithStack(err))
+			return
+		}
+
+		page = page.next(settings.Direction)
+	}
+
+	actionState.Details = fmt.Sprintf("%s;%s;%d", objectID, settings.Direction, fetched) // log details in results as {Object ID};{Direction};{Fetched pages}
+	sessionState.Wait(actionState)
+}
+
+// fetchHyperCubePage fetches page of hypercube data and sets it as current data of object
+func fetchHyperCubePage(sessionState *session.State, actionState *action.State, gob *enigma.GenericObject, obj *enigmahandlers.Object, pivot bool, page enigma.NxPage) error {
+	path, err := hyperCubeDefPath(gob)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if pivot {
+		return sessionState.SendRequest(actionState, func(ctx context.Context) error {
+			datapages, err := gob.GetHyperCubePivotData(ctx, path, []*enigma.NxPage{&page})
+			if err != nil {
+				return errors.Wrapf(err, "object<%s>.GetHyperCubePivotData failed", gob.GenericId)
+			}
+			return errors.WithStack(obj.SetPivotHyperCubePages(datapages))
+		})
+	}
+	return sessionState.SendRequest(actionState, func(ctx context.Context) error {
+		datapages, err := gob.GetHyperCubeData(ctx, path, []*enigma.NxPage{&page})
+		if err != nil {
+			return errors.Wrapf(err, "object<%s>.GetHyperCubeData failed", gob.GenericId)
+		}
+		return errors.WithStack(obj.SetHyperCubeDataPages(datapages, false))
+	})
+}
+
+// hyperCubeDefPath path to hypercube definition of object, taken from definition of object type
+func hyperCubeDefPath(gob *enigma.GenericObject) (string, error) {
+	def, err := senseobjdef.GetObjectDef(gob.GenericType)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get definition of object<%s>", gob.GenericId)
+	}
+	path, err := def.HyperCubeDefPath()
+	if err != nil {
+		return "", errors.Wrapf(err, "object<%s> type<%s>", gob.GenericId, gob.GenericType)
+	}
+	return path, nil
+}
+
+// firstPage to fetch, continues from the end of the data currently held by object
+func (settings ScrollSettings) firstPage(obj *enigmahandlers.Object, pivot bool) scrollPage {
+	var areas []*enigma.Rect
+	if pivot {
+		for _, datapage := range obj.HyperPivotPages() {
+			if datapage != nil {
+				areas = append(areas, datapage.Area)
+			}
+		}
+	} else {
+		for _, datapage := range obj.HyperCubeDataPages() {
+			if datapage != nil {
+				areas = append(areas, datapage.Area)
+			}
+		}
+	}
+
+	hypercube := obj.HyperCube()
+	return newScrollPage(settings.Direction, settings.PageSize, hypercube.Size.Cx, areas)
+}
+
+// newScrollPage creates first page in direction, starting after the end of the current areas
+func newScrollPage(direction ScrollDirection, pageSize, width int, areas []*enigma.Rect) scrollPage {
+	var page scrollPage
+	top, left := 0, 0
+	for _, area := range areas {
+		if area == nil {
+			continue
+		}
+		top = helpers.Max(top, area.Top+area.Height)
+		left = helpers.Max(left, area.Left+area.Width)
+	}
+
+	switch direction {
+	case ScrollHorizontal:
+		if pageSize < 1 {
+			pageSize = defaultScrollPageWidth
+		}
+		page.Left = left
+		page.Width = pageSize
+		page.Height = helpers.Max(1, helpers.Min(defaultScrollPageHeight, maxScrollPageCells/pageSize))
+	default:
+		if pageSize < 1 {
+			pageSize = defaultScrollPageHeight
+		}
+		width = helpers.Max(1, width)
+		page.Top = top
+		page.Width = width
+		page.Height = helpers.Max(1, helpers.Min(pageSize, maxScrollPageCells/width))
+	}
+	return page
+}
+
+// next page in direction
+func (page scrollPage) next(direction ScrollDirection) scrollPage {
+	switch direction {
+	case ScrollHorizontal:
+		page.Left += page.Width
+	default:
+		page.Top += page.Height
+	}
+	return page
+}
+
+// inside returns true if page starts inside of hypercube size
+func (page scrollPage) inside(size *enigma.Size) bool {
+	if size == nil {
+		return false
+	}
+	return page.Top < size.Cy && page.Left < size.Cx
+}
@@ -0,0 +1,83 @@
+package scenario
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/qlik-oss/enigma-go/v4"
+)
+
+func TestScrollSettingsUnmarshal(t *testing.T) {
+	raw := `{
+		"id": "table1",
+		"direction": "horizontal",
+		"pagesize": 5,
+		"pages": 3,
+		"thinktime": { "type": "static", "delay": 0.5 }
+	}`
+
+	var settings ScrollSettings
+	if err := json.Unmarshal([]byte(raw), &settings); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := settings.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if settings.Direction != ScrollHorizontal || settings.PageSize != 5 || settings.Pages != 3 || settings.ThinkTime == nil {
+		t.Errorf("unexpected settings<%+v>", settings)
+	}
+
+	invalid := []ScrollSettings{
+		{Pages: 1},
+		{ID: "table1"},
+		{ID: "table1", Pages: 1, PageSize: -1},
+		{ID: "table1", Pages: 1, Direction: ScrollDirection(5)},
+	}
+	for _, settings := range invalid {
+		if _, err := settings.Validate(); err == nil {
+			t.Errorf("expected validation error for<%+v>", settings)
+		}
+	}
+}
+
+func TestScrollPages(t *testing.T) {
+	size := &enigma.Size{Cx: 30, Cy: 100}
+
+	// table with initial column pages of height 40
+	areas := []*enigma.Rect{
+		{Left: 0, Top: 0, Width: 1, Height: 40},
+		{Left: 1, Top: 0, Width: 1, Height: 40},
+	}
+
+	page := newScrollPage(ScrollVertical, 0, size.Cx, areas)
+	expected := []enigma.NxPage{
+		{Left: 0, Top: 40, Width: 30, Height: 40},
+		{Left: 0, Top: 80, Width: 30, Height: 40},
+	}
+	for _, exp := range expected {
+		if !page.inside(size) {
+			t.Fatalf("expected page<%+v> inside size<%+v>", exp, size)
+		}
+		if page.NxPage != exp {
+			t.Errorf("unexpected page<%+v> expected<%+v>", page.NxPage, exp)
+		}
+		page = page.next(ScrollVertical)
+	}
+	if page.inside(size) {
+		t.Errorf("page<%+v> should be outside size<%+v>", page.NxPage, size)
+	}
+
+	page = newScrollPage(ScrollHorizontal, 0, size.Cx, areas)
+	if exp := (enigma.NxPage{Left: 2, Top: 0, Width: 10, Height: 40}); page.NxPage != exp {
+		t.Errorf("unexpected page<%+v> expected<%+v>", page.NxPage, exp)
+	}
+	if page = page.next(ScrollHorizontal); page.Left != 12 || page.Top != 0 {
+		t.Errorf("unexpected next page<%+v>", page.NxPage)
+	}
+
+	// page height is limited by max cells of a data page
+	page = newScrollPage(ScrollVertical, 1000, 50, nil)
+	if page.Height != 200 || page.Top != 0 {
+		t.Errorf("unexpected page<%+v>, expected height 200", page.NxPage)
+	}
+}
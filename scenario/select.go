@@ -41,6 +41,31 @@ type (
 		Dimension int `json:"dim" displayname:"Dimension to select in" doc-key:"select.dim"`
 		// Values element values to selection (using select type "values")
 		Values []int `json:"values" displayname:"Element values to select" doc-key:"select.values"`
+		// Range numeric range to select (using select type "range")
+		Range *SelectionRange `json:"range,omitempty" displayname:"Range to select" doc-key:"select.range"`
+		// SearchText text to search for and accept (using select type "search")
+		SearchText string `json:"search,omitempty" displayname:"Search text" doc-key:"select.search"`
+		// State alternate state object is expected to be bound to
+		State string `json:"state,omitempty" displayname:"Alternate state" doc-key:"select.state"`
+	}
+
+	// SelectionRangeType type of range selection
+	SelectionRangeType int
+
+	// SelectionRange numeric range to select
+	SelectionRange struct {
+		// Type select range of measure or of continuous dimension
+		Type SelectionRangeType `json:"type" displayname:"Range type" doc-key:"select.range.type"`
+		// Measure index of measure to select range in
+		Measure int `json:"measure,omitempty" displayname:"Measure" doc-key:"select.range.measure"`
+		// Min lower bound of range
+		Min float64 `json:"min" displayname:"Minimum value" doc-key:"select.range.min"`
+		// Max upper bound of range
+		Max float64 `json:"max" displayname:"Maximum value" doc-key:"select.range.max"`
+		// MinInclusive include lower bound in range
+		MinInclusive bool `json:"mininclusive,omitempty" displayname:"Include minimum value" doc-key:"select.range.mininclusive"`
+		// MaxInclusive include upper bound in range
+		MaxInclusive bool `json:"maxinclusive,omitempty" displayname:"Include maximum value" doc-key:"select.range.maxinclusive"`
 	}
 
 	selectStates int
@@ -57,6 +82,17 @@ const (
 	RandomDeselect
 	// Values select specific element values
 	Values
+	// RangeSelection select numeric range
+	RangeSelection
+	// SearchSelection select values matching search text
+	SearchSelection
+)
+
+const (
+	// SelectionRangeMeasure select range of measure values
+	SelectionRangeMeasure SelectionRangeType = iota
+	// SelectionRangeContinuous select range on continuous dimension axis
+	SelectionRangeContinuous
 )
 
 const (
@@ -76,6 +112,13 @@ var selectionTypeEnumMap = enummap.NewEnumMapOrPanic(map[string]int{
 	"randomfromexcluded": int(RandomFromExcluded),
 	"randomdeselect":     int(RandomDeselect),
 	"values":             int(Values),
+	"range":              int(RangeSelection),
+	"search":             int(SearchSelection),
+})
+
+var selectionRangeTypeEnumMap = enummap.NewEnumMapOrPanic(map[string]int{
+	"measure":    int(SelectionRangeMeasure),
+	"continuous": int(SelectionRangeContinuous),
 })
 
 // GetEnumMap returns selection type enum map to GUI
@@ -125,6 +168,36 @@ func (value SelectionType) String() string {
 	return sType
 }
 
+// GetEnumMap returns selection range type enum map to GUI
+func (value SelectionRangeType) GetEnumMap() *enummap.EnumMap {
+	return selectionRangeTypeEnumMap
+}
+
+// UnmarshalJSON unmarshal selection range type
+func (value *SelectionRangeType) UnmarshalJSON(arg []byte) error {
+	i, err := value.GetEnumMap().UnMarshal(arg)
+	if err != nil {
+		return errors.Wrap(err, "Failed to unmarshal SelectionRangeType")
+	}
+
+	*value = SelectionRangeType(i)
+	return nil
+}
+
+// MarshalJSON marshal selection range type
+func (value SelectionRangeType) MarshalJSON() ([]byte, error) {
+	str, err := value.GetEnumMap().String(int(value))
+	if err != nil {
+		return nil, errors.Errorf("Unknown selection range type<%d>", value)
+	}
+	return []byte(fmt.Sprintf(`"%s"`, str)), nil
+}
+
+// String representation of SelectionRangeType
+func (value SelectionRangeType) String() string {
+	return value.GetEnumMap().StringDefault(int(value), strconv.Itoa(int(value)))
+}
+
 // IsExcludedOrDeselect true if type is RandomDeselect or RandomFromExcluded
 func (value SelectionType) IsExcludedOrDeselect() bool {
 	switch value {
@@ -147,12 +220,20 @@ func (settings SelectionSettings) Validate() ([]string, error) {
 		return nil, errors.Errorf("Illegal dimension<%d>", settings.Dimension)
 	}
 
-	// check values but not min max for "values" type selection
-	if settings.Type == Values {
+	// check values but not min max for "values", "range" and "search" type selections
+	switch settings.Type {
+	case Values:
 		if len(settings.Values) < 1 {
 			return nil, errors.New("No element values defined for selection type values")
 		}
 		return nil, nil
+	case RangeSelection:
+		return nil, errors.WithStack(settings.Range.Validate())
+	case SearchSelection:
+		if settings.SearchText == "" {
+			return nil, errors.New("No search text defined for selection type search")
+		}
+		return nil, nil
 	}
 
 	if settings.Min < 1 {
@@ -231,10 +312,24 @@ func (settings SelectionSettings) Execute(sessionState *session.State, actionSta
 		}
 	}
 
+	if err := verifyObjectState(gob, settings.State); err != nil {
+		actionState.AddErrors(errors.WithStack(err))
+		return
+	}
+	if err := trackStateSelections(sessionState, actionState, settings.State); err != nil {
+		actionState.AddErrors(errors.WithStack(err))
+		return
+	}
+
 	switch t := gob.EnigmaObject.(type) {
 	case *enigma.GenericObject:
 		genObj := gob.EnigmaObject.(*enigma.GenericObject)
-		doSelect(sessionState, actionState, genObj, gob, settings.WrapSelections, settings.Accept, settings.Dimension, settings.Min, settings.Max, settings.Type, settings.Values)
+		switch settings.Type {
+		case RangeSelection, SearchSelection:
+			settings.doSelectRangeOrSearch(sessionState, actionState, genObj)
+		default:
+			doSelect(sessionState, actionState, genObj, gob, settings.WrapSelections, settings.Accept, settings.Dimension, settings.Min, settings.Max, settings.Type, settings.Values)
+		}
 	default:
 		actionState.AddErrors(errors.Errorf("Unknown object type<%T>", t))
 		return
@@ -948,7 +1043,11 @@ func doSelect(sessionState *session.State, actionState *action.State, genObj *en
 		return
 	}
 
-	// Select
+	queueSelect(sessionState, actionState, genObj, wrap, accept, selectFunc)
+}
+
+// queueSelect queues select request, ending selections after select when wrapped in selection mode
+func queueSelect(sessionState *session.State, actionState *action.State, genObj *enigma.GenericObject, wrap, accept bool, selectFunc func(ctx context.Context) (bool, error)) {
 	sessionState.QueueRequest(func(ctx context.Context) error {
 		sessionState.LogEntry.LogDebugf("Select in object<%s> h<%d> type<%s>", genObj.GenericId, genObj.Handle, genObj.GenericType)
 		success, err := selectFunc(ctx)
@@ -970,3 +1069,97 @@ func doSelect(sessionState *session.State, actionState *action.State, genObj *en
 		return nil
 	}, actionState, true, fmt.Sprintf("Failed to select in %s", genObj.GenericId))
 }
+
+// Validate selection range
+func (selectionRange *SelectionRange) Validate() error {
+	if selectionRange == nil {
+		return errors.New("No range defined for selection type range")
+	}
+	if _, err := selectionRangeTypeEnumMap.String(int(selectionRange.Type)); err != nil {
+		return errors.Errorf("unknown range type<%d>", selectionRange.Type)
+	}
+	if selectionRange.Measure < 0 {
+		return errors.Errorf("illegal measure<%d>", selectionRange.Measure)
+	}
+	if selectionRange.Min > selectionRange.Max {
+		return errors.Errorf("range min<%v> must be less than max<%v>", selectionRange.Min, selectionRange.Max)
+	}
+	return nil
+}
+
+// enigmaRange range in enigma format
+func (selectionRange *SelectionRange) enigmaRange() *enigma.Range {
+	return &enigma.Range{
+		Min:       enigma.Float64(selectionRange.Min),
+		Max:       enigma.Float64(selectionRange.Max),
+		MinInclEq: selectionRange.MinInclusive,
+		MaxInclEq: selectionRange.MaxInclusive,
+	}
+}
+
+// doSelectRangeOrSearch select numeric range in hypercube or accept search in listobject
+func (settings SelectionSettings) doSelectRangeOrSearch(sessionState *session.State, actionState *action.State, genObj *enigma.GenericObject) {
+	objInstance := sessionState.GetObjectHandlerInstance(genObj.GenericId, genObj.GenericType)
+	selectPath, selectType, _, err := objInstance.GetObjectDefinition(genObj.GenericType)
+	if err != nil {
+		actionState.AddErrors(err)
+		return
+	}
+
+	var selectFunc func(ctx context.Context) (bool, error)
+	switch settings.Type {
+	case RangeSelection:
+		if selectType != senseobjdef.SelectTypeHypercubeValues && selectType != senseobjdef.SelectTypeHypercubeColumnValues {
+			actionState.AddErrors(errors.Errorf("selection type<%s> not supported for object<%s> type<%s>", settings.Type, genObj.GenericId, genObj.GenericType))
+			return
+		}
+		selectionRange := settings.Range
+		actionState.Details = fmt.Sprintf("%s;%s;%v;%v", genObj.GenericId, selectionRange.Type, selectionRange.Min, selectionRange.Max)
+		switch selectionRange.Type {
+		case SelectionRangeContinuous:
+			selectFunc = func(ctx context.Context) (bool, error) {
+				return genObj.SelectHyperCubeContinuousRange(ctx, selectPath, []*enigma.NxContinuousRangeSelectInfo{{
+					Range: selectionRange.enigmaRange(),
+					DimIx: settings.Dimension,
+				}}, false)
+			}
+		default:
+			selectFunc = func(ctx context.Context) (bool, error) {
+				return genObj.RangeSelectHyperCubeValues(ctx, selectPath, []*enigma.NxRangeSelectInfo{{
+					Range:     selectionRange.enigmaRange(),
+					MeasureIx: selectionRange.Measure,
+				}}, []int{}, false, false)
+			}
+		}
+	case SearchSelection:
+		if selectType != senseobjdef.SelectTypeListObjectValues {
+			actionState.AddErrors(errors.Errorf("selection type<%s> not supported for object<%s> type<%s>", settings.Type, genObj.GenericId, genObj.GenericType))
+			return
+		}
+		actionState.Details = fmt.Sprintf("%s;%s", genObj.GenericId, settings.SearchText)
+		selectFunc = func(ctx context.Context) (bool, error) {
+			found, err := genObj.SearchListObjectFor(ctx, selectPath, settings.SearchText)
+			if err != nil || !found {
+				return found, errors.WithStack(err)
+			}
+			return true, errors.WithStack(genObj.AcceptListObjectSearch(ctx, selectPath, true, false))
+		}
+	default:
+		actionState.AddErrors(errors.Errorf("Unknown select type<%s>", settings.Type))
+		return
+	}
+
+	if settings.WrapSelections {
+		// Start selections
+		sessionState.QueueRequest(func(ctx context.Context) error {
+			return genObj.BeginSelections(ctx, []string{selectPath})
+		}, actionState, false, "")
+
+		sessionState.Wait(actionState)
+		if actionState.Errors() != nil {
+			return
+		}
+	}
+
+	queueSelect(sessionState, actionState, genObj, settings.WrapSelections, settings.Accept, selectFunc)
+}
@@ -123,6 +123,60 @@ func TestValidate(t *testing.T) {
 	validateError(t, err, "")
 }
 
+func TestSelectRangeAndSearch(t *testing.T) {
+	t.Parallel()
+
+	raw := `{
+		"label" : "select sales range",
+		"action" : "select",
+		"settings": {
+			"id" : "objid1",
+			"type" : "range",
+			"accept" : true,
+			"wrap" : true,
+			"range" : {
+				"type" : "measure",
+				"measure" : 1,
+				"min" : 100,
+				"max" : 250.5,
+				"mininclusive" : true
+			}
+		}
+	}`
+	var item Action
+	if err := json.Unmarshal([]byte(raw), &item); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	settings, ok := item.Settings.(*SelectionSettings)
+	if !ok {
+		t.Fatalf("Failed to cast item settings<%T> to *SelectionSettings", item.Settings)
+	}
+	expectedRange := SelectionRange{Type: SelectionRangeMeasure, Measure: 1, Min: 100, Max: 250.5, MinInclusive: true}
+	if settings.Type != RangeSelection || settings.Range == nil || *settings.Range != expectedRange {
+		t.Errorf("unexpected range settings<%+v> range<%+v>", settings, settings.Range)
+	}
+
+	rangeSettings := &SelectionSettings{ID: "obj1", Type: RangeSelection}
+	_, err := rangeSettings.Validate()
+	validateError(t, err, "No range defined for selection type range")
+	rangeSettings.Range = &SelectionRange{Type: SelectionRangeContinuous, Min: 2, Max: 1}
+	_, err = rangeSettings.Validate()
+	validateError(t, err, "range min<2> must be less than max<1>")
+	rangeSettings.Range.Max = 3
+	_, err = rangeSettings.Validate()
+	validateError(t, err, "")
+
+	searchSettings := &SelectionSettings{ID: "obj1", Type: SearchSelection}
+	_, err = searchSettings.Validate()
+	validateError(t, err, "No search text defined for selection type search")
+	searchSettings.SearchText = "Swe*"
+	_, err = searchSettings.Validate()
+	validateError(t, err, "")
+}
+
 func TestSelectQty(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,43 @@
+package scenario
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/gopherciser/action"
+	"github.com/qlik-oss/gopherciser/connection"
+	"github.com/qlik-oss/gopherciser/senseobjects"
+	"github.com/qlik-oss/gopherciser/session"
+)
+
+type (
+	// BackSettings step back in selection history
+	BackSettings struct{}
+
+	// ForwardSettings step forward in selection history
+	ForwardSettings struct{}
+)
+
+// Validate Back action (Implements ActionSettings interface)
+func (settings BackSettings) Validate() ([]string, error) {
+	return nil, nil
+}
+
+// Execute Back action (Implements ActionSettings interface)
+func (settings BackSettings) Execute(sessionState *session.State, actionState *action.State, connection *connection.ConnectionSettings, label string, reset func()) {
+	executeAppSelectionRequest(sessionState, actionState, "", "Failed to step back in selections", func(ctx context.Context, app *senseobjects.App) error {
+		return errors.WithStack(app.Doc.Back(ctx))
+	})
+}
+
+// Validate Forward action (Implements ActionSettings interface)
+func (settings ForwardSettings) Validate() ([]string, error) {
+	return nil, nil
+}
+
+// Execute Forward action (Implements ActionSettings interface)
+func (settings ForwardSettings) Execute(sessionState *session.State, actionState *action.State, connection *connection.ConnectionSettings, label string, reset func()) {
+	executeAppSelectionRequest(sessionState, actionState, "", "Failed to step forward in selections", func(ctx context.Context, app *senseobjects.App) error {
+		return errors.WithStack(app.Doc.Forward(ctx))
+	})
+}
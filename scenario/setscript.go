@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
+	"github.com/qlik-oss/enigma-go/v4"
 	"github.com/qlik-oss/gopherciser/action"
 	"github.com/qlik-oss/gopherciser/connection"
 	"github.com/qlik-oss/gopherciser/session"
@@ -37,12 +38,8 @@ func (settings SetScriptSettings) Execute(sessionState *session.State,
 		return
 	}
 
-	doSet := func(ctx context.Context) error {
-		err := app.Doc.SetScript(ctx, settings.Script)
-		return err
-	}
-	if err := sessionState.SendRequest(actionState, doSet); err != nil {
-		actionState.AddErrors(errors.Wrap(err, "failed to set script"))
+	if err := setScript(sessionState, actionState, app.Doc, settings.Script); err != nil {
+		actionState.AddErrors(err)
 		return
 	}
 
@@ -53,3 +50,14 @@ func (settings SetScriptSettings) Execute(sessionState *session.State,
 
 	sessionState.Wait(actionState)
 }
+
+// setScript sets script of app without saving it
+func setScript(sessionState *session.State, actionState *action.State, doc *enigma.Doc, script string) error {
+	doSet := func(ctx context.Context) error {
+		return doc.SetScript(ctx, script)
+	}
+	if err := sessionState.SendRequest(actionState, doSet); err != nil {
+		return errors.Wrap(err, "failed to set script")
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package scenario
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-json"
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/enigma-go/v4"
+	"github.com/qlik-oss/gopherciser/action"
+	"github.com/qlik-oss/gopherciser/connection"
+	"github.com/qlik-oss/gopherciser/helpers"
+	"github.com/qlik-oss/gopherciser/logger"
+	"github.com/qlik-oss/gopherciser/session"
+	"github.com/qlik-oss/gopherciser/synced"
+)
+
+type (
+	// VariableInputSettings set variable of a variable input object
+	VariableInputSettings struct {
+		ID    string          `json:"id" displayname:"Object ID" doc-key:"variableinput.id" appstructure:"active:qlik-variable-input"`
+		Value synced.Template `json:"value,omitempty" displayname:"Value" doc-key:"variableinput.value"`
+	}
+
+	// variableInputLayout properties of variable input extension
+	variableInputLayout struct {
+		enigma.GenericObjectLayout
+		VariableName  string               `json:"variableName"`
+		Render        string               `json:"render"`
+		ValueType     string               `json:"valueType"`
+		Values        []variableInputValue `json:"values"`
+		DynamicValues string               `json:"dynamicvalues"`
+		Min           float64              `json:"min"`
+		Max           float64              `json:"max"`
+		Step          float64              `json:"step"`
+	}
+
+	// variableInputValue fixed value of variable input
+	variableInputValue struct {
+		Value string `json:"value"`
+		Label string `json:"label"`
+	}
+)
+
+const (
+	variableInputObjectType = "qlik-variable-input"
+
+	// variable input render modes
+	variableInputRenderSlider = "f"
+	variableInputRenderInput  = "i"
+
+	// variableInputDynamicValues value type where values are defined by expression
+	variableInputDynamicValues = "d"
+)
+
+// Validate VariableInput action (Implements ActionSettings interface)
+func (settings VariableInputSettings) Validate() ([]string, error) {
+	if settings.ID == "" {
+		return nil, errors.Errorf("no object id defined for %s", ActionVariableInput)
+	}
+	return nil, nil
+}
+
+// Execute VariableInput action (Implements ActionSettings interface)
+func (settings VariableInputSettings) Execute(sessionState *session.State, actionState *action.State, connection *connection.ConnectionSettings, label string, reset func()) {
+	if sessionState.Connection == nil || sessionState.Connection.Sense() == nil {
+		actionState.AddErrors(errors.New("Not connected to a Sense environment"))
+		return
+	}
+	uplink := sessionState.Connection.Sense()
+	app := uplink.CurrentApp
+	if app == nil {
+		actionState.AddErrors(errors.New("Not connected to a Sense app"))
+		return
+	}
+
+	objectID := sessionState.IDMap.Get(settings.ID)
+	obj, err := uplink.Objects.GetObjectByID(objectID)
+	if err != nil {
+		actionState.AddErrors(errors.Wrapf(err, "Failed getting object<%s> from object list", objectID))
+		return
+	}
+	gob, ok := obj.EnigmaObject.(*enigma.GenericObject)
+	if !ok {
+		actionState.AddErrors(errors.Errorf("Failed to cast object<%s> to *enigma.GenericObject", objectID))
+		return
+	}
+	if gob.GenericType != variableInputObjectType {
+		actionState.AddErrors(errors.Errorf("object<%s> type<%s> is not a %s", objectID, gob.GenericType, variableInputObjectType))
+		return
+	}
+
+	layoutRaw, err := sessionState.SendRequestRaw(actionState, gob.GetLayoutRaw)
+	if err != nil {
+		actionState.AddErrors(errors.Wrapf(err, "failed to get layout of object<%s>", objectID))
+		return
+	}
+	var layout variableInputLayout
+	if err := json.Unmarshal(layoutRaw, &layout); err != nil {
+		actionState.AddErrors(errors.Wrapf(err, "failed to unmarshal layout of object<%s>", objectID))
+		return
+	}
+	if layout.VariableName == "" {
+		actionState.AddErrors(errors.Errorf("object<%s> has no variable defined", objectID))
+		return
+	}
+
+	value := ""
+	if settings.Value.String() != "" {
+		value, err = sessionState.ReplaceSessionVariables(&settings.Value)
+		if err != nil {
+			actionState.AddErrors(err)
+			return
+		}
+	} else {
+		value, err = layout.randomValue(sessionState.Randomizer())
+		if err != nil {
+			actionState.AddErrors(errors.Wrapf(err, "object<%s>", objectID))
+			return
+		}
+		if value == "" {
+			sessionState.LogEntry.Logf(logger.WarningLevel, "object<%s> has no values to set variable<%s> to", objectID, layout.VariableName)
+			return
+		}
+	}
+
+	actionState.Details = fmt.Sprintf("%s;%s;%s", objectID, layout.VariableName, value) // log details in results as {Object ID};{Variable};{Value}
+
+	sessionState.QueueRequest(func(ctx context.Context) error {
+		variable, err := varReq(app.Doc.GetVariableByName).WithCache(&uplink.VarCache)(ctx, layout.VariableName)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		// slider sets numeric value, other inputs set the value as string
+		if layout.Render == variableInputRenderSlider {
+			num, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return errors.Wrapf(err, "slider value<%s> is not numeric", value)
+			}
+			return errors.WithStack(variable.SetNumValue(ctx, enigma.Float64(num)))
+		}
+		return errors.WithStack(variable.SetStringValue(ctx, value))
+	}, actionState, true, fmt.Sprintf("Failed to set variable<%s>", layout.VariableName))
+
+	sessionState.Wait(actionState)
+}
+
+// randomValue chooses random value from values defined by variable input, empty string if there is no value
+func (layout *variableInputLayout) randomValue(randomizer helpers.Randomizer) (string, error) {
+	switch layout.Render {
+	case variableInputRenderInput:
+		return "", errors.New("input box requires a value to be defined")
+	case variableInputRenderSlider:
+		if layout.Max < layout.Min {
+			return "", errors.Errorf("illegal slider range min<%v> max<%v>", layout.Min, layout.Max)
+		}
+		steps := 1
+		if layout.Step > 0 {
+			steps = int(math.Floor((layout.Max-layout.Min)/layout.Step)) + 1
+		}
+		value := layout.Min + float64(randomizer.Rand(steps))*layout.Step
+		return strconv.FormatFloat(value, 'f', -1, 64), nil
+	default:
+		values := layout.values()
+		if len(values) < 1 {
+			return "", nil
+		}
+		return values[randomizer.Rand(len(values))], nil
+	}
+}
+
+// values selectable in buttons or drop down, dynamic values are defined as "value~label|value~label"
+func (layout *variableInputLayout) values() []string {
+	var values []string
+	if layout.ValueType == variableInputDynamicValues {
+		for _, item := range strings.Split(layout.DynamicValues, "|") {
+			if value, _, _ := strings.Cut(item, "~"); value != "" {
+				values = append(values, value)
+			}
+		}
+		return values
+	}
+	for _, item := range layout.Values {
+		if item.Value != "" {
+			values = append(values, item.Value)
+		}
+	}
+	return values
+}
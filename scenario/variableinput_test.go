@@ -0,0 +1,44 @@
+package scenario
+
+import (
+	"testing"
+
+	"github.com/qlik-oss/gopherciser/randomizer"
+	"github.com/qlik-oss/gopherciser/session"
+)
+
+func TestVariableInputValues(t *testing.T) {
+	rnd := &session.DefaultRandomizer{Randomizer: randomizer.NewSeededRandomizer(1)}
+
+	dynamic := variableInputLayout{ValueType: "d", DynamicValues: "a~Alpha|b~Beta|c"}
+	values := dynamic.values()
+	if len(values) != 3 || values[0] != "a" || values[1] != "b" || values[2] != "c" {
+		t.Errorf("unexpected dynamic values<%v>", values)
+	}
+
+	fixed := variableInputLayout{ValueType: "f", Values: []variableInputValue{{Value: "x", Label: "X"}, {Value: ""}}}
+	if values := fixed.values(); len(values) != 1 || values[0] != "x" {
+		t.Errorf("unexpected fixed values<%v>", values)
+	}
+
+	slider := variableInputLayout{Render: variableInputRenderSlider, Min: 10, Max: 20, Step: 5}
+	for i := 0; i < 20; i++ {
+		value, err := slider.randomValue(rnd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value != "10" && value != "15" && value != "20" {
+			t.Errorf("unexpected slider value<%s>", value)
+		}
+	}
+
+	input := variableInputLayout{Render: variableInputRenderInput}
+	if _, err := input.randomValue(rnd); err == nil {
+		t.Error("expected error for input box without value")
+	}
+
+	empty := variableInputLayout{}
+	if value, err := empty.randomValue(rnd); err != nil || value != "" {
+		t.Errorf("unexpected value<%s> err<%v> for empty values", value, err)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/qlik-oss/gopherciser/scenario"
 	"github.com/qlik-oss/gopherciser/session"
 	"github.com/qlik-oss/gopherciser/statistics"
+	"github.com/qlik-oss/gopherciser/tracing"
 	"github.com/qlik-oss/gopherciser/users"
 )
 
@@ -263,7 +264,9 @@ func (sched *Scheduler) StartNewUser(ctx context.Context, timeout time.Duration,
 			return errors.WithStack(err)
 		}
 
+		sessionState.Trace = tracing.StartSession(sessionID, thread, userName)
 		err := sched.runIteration(userScenario, sessionState, ctx)
+		sessionState.Trace.End(err)
 		if err != nil {
 			mErr = multierror.Append(mErr, err)
 		}
@@ -378,6 +378,44 @@ func (def *ObjectDef) Validate() error {
 	return nil
 }
 
+// HyperCubeDefPath path to hypercube definition in object properties, used by hypercube methods such as
+// GetHyperCubeData. Path is taken from the first hypercube data request with a path, from the select definition or,
+// as the hypercube definition is found on the path of the layout data carrier with suffix "Def", from the data
+// definition.
+func (def *ObjectDef) HyperCubeDefPath() (string, error) {
+	if def == nil {
+		return "", errors.Errorf("object definition is nil")
+	}
+	if def.DataDef.Type != DataDefHyperCube {
+		str, _ := dataDefTypeEnum.String(int(def.DataDef.Type))
+		return "", errors.Errorf("data def type<%s> is not a hypercube", str)
+	}
+
+	for _, d := range def.Data {
+		for _, r := range d.Requests {
+			switch r.Type {
+			case DataTypeLayout, DataTypeListObject:
+			default:
+				if r.Path != "" {
+					return r.Path, nil
+				}
+			}
+		}
+	}
+
+	if def.Select != nil && def.Select.Path != "" {
+		switch def.Select.Type {
+		case SelectTypeHypercubeValues, SelectTypeHypercubeColumnValues:
+			return def.Select.Path, nil
+		}
+	}
+
+	if def.DataDef.Path == "" {
+		return "", errors.Errorf("hypercube data def has no path")
+	}
+	return string(def.DataDef.Path) + "Def", nil
+}
+
 // Evaluate which constraint section applies
 func (def *ObjectDef) Evaluate(data json.RawMessage) ([]GetDataRequests, error) {
 	for _, v := range def.Data {
@@ -381,6 +381,39 @@ func TestDefault(t *testing.T) {
 	// todo add multi data request object test
 }
 
+func TestHyperCubeDefPath(t *testing.T) {
+	tests := []struct {
+		object string
+		path   string
+	}{
+		{"table", "/qHyperCubeDef"},                       // data request
+		{"map", "/qUndoExclude/gaLayers/0/qHyperCubeDef"}, // select
+		{"sn-pivot-table", "/qHyperCubeDef"},              // data def
+	}
+	for _, test := range tests {
+		def, err := GetObjectDef(test.object)
+		if err != nil {
+			t.Fatal(err)
+		}
+		path, err := def.HyperCubeDefPath()
+		if err != nil {
+			t.Errorf("object<%s> unexpected error: %v", test.object, err)
+			continue
+		}
+		if path != test.path {
+			t.Errorf("object<%s> hypercube def path<%s> expected<%s>", test.object, path, test.path)
+		}
+	}
+
+	listbox, err := GetObjectDef("listbox")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := listbox.HyperCubeDefPath(); err == nil {
+		t.Error("expected error for listbox hypercube def path")
+	}
+}
+
 func validateConfigStruct(t *testing.T, defs ObjectDefs, tests ObjectDefs) {
 	t.Helper()
 
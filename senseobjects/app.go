@@ -16,6 +16,7 @@ type (
 		sheetList         *SheetList
 		bookmarkList      *BookmarkList
 		currentSelections *CurrentSelections
+		stateSelections   map[string]*CurrentSelections
 		localeInfo        *enigma.LocaleInfo
 		variablelist      *VariableList
 		storylist         *StoryList
@@ -451,24 +452,32 @@ func (app *App) GetFieldList(sessionState SessionState, actionState *action.Stat
 
 // GetCurrentSelections create current selection session object and add to list
 func (app *App) GetCurrentSelections(sessionState SessionState, actionState *action.State, requestData bool) (*CurrentSelections, error) {
-	if app.currentSelections != nil {
-		if requestData && app.currentSelections.enigmaObject != nil {
-			if f := sessionState.GetEventFunc(app.currentSelections.enigmaObject.Handle); f != nil {
+	return app.GetStateCurrentSelections(sessionState, actionState, "", requestData)
+}
+
+// GetStateCurrentSelections create current selection session object for alternate state and add to list, empty state
+// is default state
+func (app *App) GetStateCurrentSelections(sessionState SessionState, actionState *action.State, state string, requestData bool) (*CurrentSelections, error) {
+	if cs := app.stateCurrentSelections(state); cs != nil {
+		if requestData && cs.enigmaObject != nil {
+			if f := sessionState.GetEventFunc(cs.enigmaObject.Handle); f != nil {
 				if err := f(sessionState.BaseContext(), actionState); err != nil {
-					return app.currentSelections, errors.WithStack(err)
+					return cs, errors.WithStack(err)
 				}
 			}
 		}
-		return app.currentSelections, nil
+		return cs, nil
 	}
 
 	// Create session object
+	var cs *CurrentSelections
 	updateCurrentSelections := func(ctx context.Context) error {
-		cs, err := CreateCurrentSelections(ctx, app.Doc)
+		var err error
+		cs, err = CreateStateCurrentSelections(ctx, app.Doc, state)
 		if err != nil {
 			return err
 		}
-		app.setCurrentSelections(sessionState, cs)
+		app.setStateCurrentSelections(sessionState, state, cs)
 		return nil
 	}
 	if err := sessionState.SendRequest(actionState, updateCurrentSelections); err != nil {
@@ -480,12 +489,12 @@ func (app *App) GetCurrentSelections(sessionState SessionState, actionState *act
 	onCurrentSelectionChanged := func(ctx context.Context, actionState *action.State) error {
 		var err error
 		once.Do(func() {
-			err = sessionState.SendRequest(actionState, app.currentSelections.UpdateProperties)
+			err = sessionState.SendRequest(actionState, cs.UpdateProperties)
 		})
 		if err != nil {
 			return errors.WithStack(err)
 		}
-		return errors.WithStack(app.currentSelections.UpdateLayout(ctx))
+		return errors.WithStack(cs.UpdateLayout(ctx))
 	}
 
 	if requestData {
@@ -494,9 +503,9 @@ func (app *App) GetCurrentSelections(sessionState SessionState, actionState *act
 		}
 	}
 
-	sessionState.RegisterEvent(app.currentSelections.enigmaObject.Handle, onCurrentSelectionChanged, nil, true)
+	sessionState.RegisterEvent(cs.enigmaObject.Handle, onCurrentSelectionChanged, nil, true)
 
-	return app.currentSelections, nil
+	return cs, nil
 }
 
 // GetLocaleInfo send get locale info request
@@ -517,13 +526,33 @@ func (app *App) GetLocaleInfo(ctx context.Context) (*enigma.LocaleInfo, error) {
 	return localeInfo, nil
 }
 
-func (app *App) setCurrentSelections(sessionState SessionState, cs *CurrentSelections) {
+func (app *App) setStateCurrentSelections(sessionState SessionState, state string, cs *CurrentSelections) {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	current := app.currentSelections
+	if !IsDefaultState(state) {
+		current = app.stateSelections[state]
+	}
+	if current != nil && current.enigmaObject != nil && current.enigmaObject.Handle > 0 && cs != current {
+		sessionState.DeRegisterEvent(current.enigmaObject.Handle)
+	}
+	if IsDefaultState(state) {
+		app.currentSelections = cs
+		return
+	}
+	if app.stateSelections == nil {
+		app.stateSelections = make(map[string]*CurrentSelections)
+	}
+	app.stateSelections[state] = cs
+}
+
+func (app *App) stateCurrentSelections(state string) *CurrentSelections {
 	app.mutex.Lock()
 	defer app.mutex.Unlock()
-	if app.currentSelections != nil && app.currentSelections.enigmaObject != nil && app.currentSelections.enigmaObject.Handle > 0 && cs != app.currentSelections {
-		sessionState.DeRegisterEvent(app.currentSelections.enigmaObject.Handle)
+	if IsDefaultState(state) {
+		return app.currentSelections
 	}
-	app.currentSelections = cs
+	return app.stateSelections[state]
 }
 
 func (app *App) setFieldList(SessionState SessionState, fl *FieldList) {
@@ -2,6 +2,7 @@ package senseobjects
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
 	"github.com/goccy/go-json"
@@ -9,6 +10,9 @@ import (
 	"github.com/qlik-oss/enigma-go/v4"
 )
 
+// DefaultState name of default selection state
+const DefaultState = "$"
+
 type (
 	// CurrentSelectionLayout layout object
 	CurrentSelectionLayout struct {
@@ -92,6 +96,11 @@ func (cs *CurrentSelections) Properties() *CurrentSelectionProperties {
 
 // CreateCurrentSelections create current selections session object
 func CreateCurrentSelections(ctx context.Context, doc *enigma.Doc) (*CurrentSelections, error) {
+	return CreateStateCurrentSelections(ctx, doc, "")
+}
+
+// CreateStateCurrentSelections create current selections session object for alternate state, empty state is default state
+func CreateStateCurrentSelections(ctx context.Context, doc *enigma.Doc, state string) (*CurrentSelections, error) {
 	properties := &enigma.GenericObjectProperties{
 		Info: &enigma.NxInfo{
 			Id:   "CurrentSelection",
@@ -99,6 +108,10 @@ func CreateCurrentSelections(ctx context.Context, doc *enigma.Doc) (*CurrentSele
 		},
 		SelectionObjectDef: &enigma.SelectionObjectDef{},
 	}
+	if !IsDefaultState(state) {
+		properties.Info.Id = fmt.Sprintf("CurrentSelection-%s", state)
+		properties.StateName = state
+	}
 
 	// Create current selection object
 	obj, err := doc.CreateSessionObjectRaw(ctx, properties)
@@ -112,3 +125,8 @@ func CreateCurrentSelections(ctx context.Context, doc *enigma.Doc) (*CurrentSele
 
 	return cs, nil
 }
+
+// IsDefaultState returns true if state is empty or the default state $
+func IsDefaultState(state string) bool {
+	return state == "" || state == DefaultState
+}
@@ -0,0 +1,273 @@
+package session
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/goccy/go-json"
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/gopherciser/enummap"
+	"github.com/qlik-oss/gopherciser/helpers"
+	"github.com/qlik-oss/gopherciser/logger"
+)
+
+type (
+	FailLevel      int
+	ValidationType int
+
+	ValidatorCore struct {
+		Type  ValidationType `json:"type" doc-key:"hook.extractor.validator.type" displayname:"Type"`
+		Value string         `json:"value" doc-key:"hook.extractor.validator.value" displayname:"Value"`
+
+		value   interface{} // GUI can't handle interface{} so have to expose a string, convert real value and put here
+		convert sync.Once
+	}
+
+	Validator struct {
+		ValidatorCore
+	}
+
+	Extractor struct {
+		Name      string           `Json:"name" doc-key:"hook.extractor.name" displayname:"Name"`
+		Path      helpers.DataPath `json:"path" doc-key:"hook.extractor.path" displayname:"Path"`
+		Level     FailLevel        `json:"faillevel" doc-key:"hook.extractor.faillevel" displayname:"Fail level"`
+		Validator *Validator       `json:"validator" doc-key:"hook.extractor.validator" displayname:"Validator"`
+	}
+)
+
+var (
+	// FailLevel enumeration
+	failLevelEnum = enummap.NewEnumMapOrPanic(map[string]int{
+		"none":    int(FailLevelNone),
+		"info":    int(FailLevelInfo),
+		"warning": int(FailLevelWarning),
+		"error":   int(FailLevelError),
+	})
+
+	// ValidationType enumeration
+	validationTypeEnum = enummap.NewEnumMapOrPanic(map[string]int{
+		"none":   int(ValidationTypeNone),
+		"bool":   int(ValidationTypeBool),
+		"number": int(ValidationTypeNumber),
+		"string": int(ValidationTypeString),
+	})
+)
+
+// FailLevel
+const (
+	FailLevelError FailLevel = iota
+	FailLevelWarning
+	FailLevelInfo
+	FailLevelNone
+)
+
+// ValidationType
+const (
+	ValidationTypeNone ValidationType = iota
+	ValidationTypeBool
+	ValidationTypeNumber
+	ValidationTypeString
+)
+
+// GetEnumMap of FailLevel for GUI
+func (fl FailLevel) GetEnumMap() *enummap.EnumMap {
+	return failLevelEnum
+}
+
+// GetEnumMap of ValidationType for GUI
+func (val ValidationType) GetEnumMap() *enummap.EnumMap {
+	return validationTypeEnum
+}
+
+// UnmarshalJSON Validator
+func (validator *Validator) UnmarshalJSON(arg []byte) error {
+	err := json.Unmarshal(arg, &validator.ValidatorCore)
+	if err != nil {
+		return err
+	}
+
+	return validator.convertString()
+}
+
+// UnmarshalJSON ValidationType
+func (typ *ValidationType) UnmarshalJSON(arg []byte) error {
+	i, err := validationTypeEnum.UnMarshal(arg)
+	if err != nil {
+		return errors.Wrap(err, "Failed to unmarshal validationTypeEnum")
+	}
+	*typ = ValidationType(i)
+	return nil
+}
+
+// MarshalJSON marshal ValidationType
+func (typ ValidationType) MarshalJSON() ([]byte, error) {
+	str, err := validationTypeEnum.String(int(typ))
+	if err != nil {
+		return nil, errors.Errorf("Unknown ValidationType<%d>", typ)
+	}
+	return []byte(fmt.Sprintf(`"%s"`, str)), nil
+}
+
+// UnmarshalJSON FailLevel
+func (lvl *FailLevel) UnmarshalJSON(arg []byte) error {
+	i, err := failLevelEnum.UnMarshal(arg)
+	if err != nil {
+		return errors.Wrap(err, "Failed to unmarshal failLevelEnum")
+	}
+	*lvl = FailLevel(i)
+	return nil
+}
+
+// MarshalJSON marshal ValidationType
+func (lvl FailLevel) MarshalJSON() ([]byte, error) {
+	str, err := failLevelEnum.String(int(lvl))
+	if err != nil {
+		return nil, errors.Errorf("Unknown FailLevel<%d>", lvl)
+	}
+	return []byte(fmt.Sprintf(`"%s"`, str)), nil
+}
+
+// Validate extractor
+func (extractor *Extractor) Validate() error {
+	if extractor == nil {
+		return nil
+	}
+	if extractor.Name == "" {
+		return errors.New("no key name defined for extractor")
+	}
+	if extractor.Path.String() == "" {
+		return errors.New("no path defined for extractor")
+	}
+
+	return extractor.Validator.Validate()
+}
+
+// Validate validator settings
+func (validator *Validator) Validate() error {
+	if validator == nil {
+		return nil
+	}
+
+	if err := validator.convertString(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch validator.Type {
+	case ValidationTypeBool:
+		if _, isBool := validator.value.(bool); !isBool {
+			return errors.Errorf("validator value<%v> not of type bool", validator.Value)
+		}
+	case ValidationTypeNumber:
+		if _, isFloat := validator.value.(float64); !isFloat {
+			return errors.Errorf("validator value<%v> not of type number", validator.Value)
+		}
+	case ValidationTypeString:
+		if _, isString := validator.value.(string); !isString {
+			return errors.Errorf("validator value<%v> not of type string", validator.Value)
+		}
+	case ValidationTypeNone:
+	default:
+		return errors.Errorf("ValidationType<%v> not supported", validator.Type)
+	}
+	return nil
+}
+
+func (validator *Validator) convertString() error {
+	var err error
+	validator.convert.Do(func() {
+		switch validator.Type {
+		case ValidationTypeBool:
+			validator.value, err = strconv.ParseBool(validator.Value)
+			if err != nil {
+				err = errors.Errorf("value<%s> is not boolean", validator.Value)
+			}
+		case ValidationTypeNumber:
+			validator.value, err = strconv.ParseFloat(validator.Value, 64)
+			if err != nil {
+				err = errors.Errorf("value<%s> is not a number", validator.Value)
+			}
+		case ValidationTypeString:
+			validator.value = validator.Value
+		}
+	})
+	return err
+}
+
+// ExtractAndValidate extracts values from source and validates them, validated values are passed to set.
+// Extraction stops at the first failed validation, which is returned as error or logged depending on fail level.
+func ExtractAndValidate(extractors []Extractor, source []byte, logEntry *logger.LogEntry, set func(name, value string)) error {
+	for _, extractor := range extractors {
+		value, err := extractor.Path.LookupNoQuotes(source)
+		if err != nil {
+			return reportValidation(logEntry, extractor, err)
+		}
+		strValue := string(value)
+		if err := extractor.Validator.ValidateValue(strValue); err != nil {
+			return reportValidation(logEntry, extractor, err)
+		}
+		set(extractor.Name, strValue)
+	}
+	return nil
+}
+
+func reportValidation(logEntry *logger.LogEntry, extractor Extractor, err error) error {
+	switch extractor.Level {
+	case FailLevelError:
+		return errors.WithStack(err)
+	case FailLevelWarning:
+		logEntry.Logf(logger.WarningLevel, "extractor %s validation failed: %v", extractor.Name, err)
+		return nil
+	case FailLevelInfo:
+		logEntry.LogInfo("ExtractorValidation", fmt.Sprintf("extractor %s validation failed: %v", extractor.Name, err))
+		return nil
+	case FailLevelNone:
+		return nil
+	}
+	return errors.Errorf("unknown faillevel<%s>", failLevelEnum.StringDefault(int(extractor.Level), fmt.Sprintf("%v", extractor.Level)))
+}
+
+// Validate validation rule
+func (val *Validator) ValidateValue(value string) error {
+	if val == nil {
+		return nil
+	}
+	switch val.Type {
+	case ValidationTypeNumber:
+		floatValA, ok := val.value.(float64)
+		if !ok {
+			return errors.Errorf("value<%v> type<%T> not a float64", val.Value, val.Value)
+		}
+		floatValB, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return errors.Errorf("extracted value<%s> not a number", value)
+		}
+		if helpers.NearlyEqual(floatValA, floatValB) {
+			return nil
+		}
+		return errors.Errorf("value<%v> and extracted value<%s> not equal", val.Value, value)
+	case ValidationTypeString:
+		str, ok := val.value.(string)
+		if !ok {
+			return errors.Errorf("value<%v> type<%T> not a string", val.Value, val.Value)
+		}
+		if str == value {
+			return nil
+		}
+		return errors.Errorf("value<%s> and extracted value<%s> not equal", str, value)
+	case ValidationTypeBool:
+		boolValA, ok := val.value.(bool)
+		if !ok {
+			return errors.Errorf("value<%v> type<%T> not a bool", val.Value, val.Value)
+		}
+		boolValB, err := strconv.ParseBool(value)
+		if err != nil {
+			return errors.Errorf("extracted value<%v> not a bool", value)
+		}
+		if boolValA == boolValB {
+			return nil
+		}
+		return errors.Errorf("value<%v> and extracted value<%v> not equal", val.Value, value)
+	}
+	return nil
+}
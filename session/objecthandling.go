@@ -97,11 +97,21 @@ func (err NxValidationError) Message() string {
 	return err.Err.ExtendedMessage
 }
 
+// ErrorCategory implements logger.ErrorCategorizer interface
+func (err NxValidationError) ErrorCategory() logger.ErrorCategory {
+	return logger.ErrorCategoryValidation
+}
+
 // Error implements error interface
 func (err CalcEvalConditionFailedError) Error() string {
 	return fmt.Sprintf("object has unsatisfied calculation condition in %s", string(err))
 }
 
+// ErrorCategory implements logger.ErrorCategorizer interface
+func (err CalcEvalConditionFailedError) ErrorCategory() logger.ErrorCategory {
+	return logger.ErrorCategoryValidation
+}
+
 // RegisterHandler for object type, override existing handler with override flag
 func (objects *objectHandlerMap) RegisterHandler(objectType string, handler ObjectHandler, override bool) error {
 	objects.writeLock.Lock()
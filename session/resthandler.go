@@ -31,6 +31,7 @@ import (
 	"github.com/qlik-oss/gopherciser/requestmetrics"
 	"github.com/qlik-oss/gopherciser/runid"
 	"github.com/qlik-oss/gopherciser/statistics"
+	"github.com/qlik-oss/gopherciser/tracing"
 	"github.com/qlik-oss/gopherciser/version"
 	"github.com/rs/dnscache"
 )
@@ -39,6 +40,12 @@ type (
 	// RestMethod method with which to execute request
 	RestMethod int
 
+	// ResponseStatusError response had an unexpected status code
+	ResponseStatusError struct {
+		Code     int
+		Expected []int
+	}
+
 	// RestHandler handles waiting for pending requests and responses
 
 	RestHandler struct {
@@ -59,6 +66,7 @@ type (
 		ContentType        string
 		Content            []byte
 		ContentReader      io.Reader
+		ContentLength      int64
 		Destination        string
 		response           *http.Response
 		ResponseBody       []byte
@@ -242,6 +250,11 @@ func (method RestMethod) String() string {
 	return str
 }
 
+// GetEnumMap returns RestMethod enum map to GUI
+func (method RestMethod) GetEnumMap() *enummap.EnumMap {
+	return restMethodEnumMap
+}
+
 // DefaultClient creates client instance with default client settings
 func DefaultClient(allowUntrusted bool, state *State) (*http.Client, error) {
 	// todo client values are currently from http.DefaultTransport, should choose better values depending on
@@ -544,19 +557,50 @@ func (handler *RestHandler) OptionsSyncWithCallback(url string, actionState *act
 	return handler.sendSyncWithCallback(OPTIONS, url, actionState, logEntry, nil, headers, options, callback)
 }
 
+// SendSync send sync request with method, content, headers and options, using options=nil default options are used
+func (handler *RestHandler) SendSync(method RestMethod, url string, actionState *action.State, logEntry *logger.LogEntry, content []byte, headers map[string]string, options *ReqOptions) (*RestRequest, error) {
+	return handler.sendSyncWithCallback(method, url, actionState, logEntry, content, headers, options, nil)
+}
+
+// PostReaderSync send sync POST request with content streamed from reader, size is the length of content or 0 if
+// unknown, which sends content chunked. Using options=nil default options are used
+func (handler *RestHandler) PostReaderSync(url string, actionState *action.State, logEntry *logger.LogEntry, content io.Reader, size int64, options *ReqOptions) (*RestRequest, error) {
+	if options == nil {
+		options = &defaultReqOptions
+	}
+
+	sendRequest := RestRequest{
+		Method:         POST,
+		ContentType:    options.ContentType,
+		ContentReader:  content,
+		ContentLength:  size,
+		Destination:    url,
+		NoVirtualProxy: options.NoVirtualProxy,
+	}
+
+	return handler.queueRequestSync(&sendRequest, actionState, logEntry, options, nil)
+}
+
 func (handler *RestHandler) sendSyncWithCallback(method RestMethod, url string, actionState *action.State, logEntry *logger.LogEntry, content []byte, headers map[string]string, options *ReqOptions, callback func(err error, req *RestRequest)) (*RestRequest, error) {
+	if options == nil {
+		options = &defaultReqOptions
+	}
+	return handler.queueRequestSync(newRestRequest(method, url, content, headers, options), actionState, logEntry, options, callback)
+}
+
+func (handler *RestHandler) queueRequestSync(request *RestRequest, actionState *action.State, logEntry *logger.LogEntry, options *ReqOptions, callback func(err error, req *RestRequest)) (*RestRequest, error) {
 	var returnErr error
 	var wg sync.WaitGroup
 	wg.Add(1)
-	returnReq := handler.sendAsyncWithCallback(method, url, actionState, logEntry, content, headers, options, func(err error, req *RestRequest) {
+	handler.QueueRequestWithCallback(actionState, options.FailOnError, request, logEntry, createStatusCallback(actionState, logEntry, request, options, func(err error, req *RestRequest) {
 		defer wg.Done()
 		returnErr = err
 		if callback != nil {
 			callback(err, req)
 		}
-	})
+	}))
 	wg.Wait()
-	return returnReq, returnErr
+	return request, returnErr
 }
 
 func (handler *RestHandler) sendAsyncWithCallback(method RestMethod, url string, actionState *action.State, logEntry *logger.LogEntry, content []byte, headers map[string]string, options *ReqOptions, callback func(err error, req *RestRequest)) *RestRequest {
@@ -564,7 +608,14 @@ func (handler *RestHandler) sendAsyncWithCallback(method RestMethod, url string,
 		options = &defaultReqOptions
 	}
 
-	sendRequest := RestRequest{
+	sendRequest := newRestRequest(method, url, content, headers, options)
+	handler.QueueRequestWithCallback(actionState, options.FailOnError, sendRequest, logEntry, createStatusCallback(actionState, logEntry, sendRequest, options, callback))
+
+	return sendRequest
+}
+
+func newRestRequest(method RestMethod, url string, content []byte, headers map[string]string, options *ReqOptions) *RestRequest {
+	return &RestRequest{
 		Method:         method,
 		ContentType:    options.ContentType,
 		Content:        content,
@@ -572,10 +623,6 @@ func (handler *RestHandler) sendAsyncWithCallback(method RestMethod, url string,
 		NoVirtualProxy: options.NoVirtualProxy,
 		ExtraHeaders:   headers,
 	}
-
-	handler.QueueRequestWithCallback(actionState, options.FailOnError, &sendRequest, logEntry, createStatusCallback(actionState, logEntry, &sendRequest, options, callback))
-
-	return &sendRequest
 }
 
 func createStatusCallback(actionState *action.State, logEntry *logger.LogEntry, request *RestRequest, options *ReqOptions, callback func(err error, req *RestRequest)) func(err error, req *RestRequest) {
@@ -606,7 +653,17 @@ func CheckResponseStatus(request *RestRequest, statusCodes []int) error {
 			return nil
 		}
 	}
-	return errors.Errorf("unexpected response status code<%d> expected<%v>", request.ResponseStatusCode, statusCodes)
+	return errors.WithStack(ResponseStatusError{Code: request.ResponseStatusCode, Expected: statusCodes})
+}
+
+// Error implements error interface
+func (err ResponseStatusError) Error() string {
+	return fmt.Sprintf("unexpected response status code<%d> expected<%v>", err.Code, err.Expected)
+}
+
+// StatusCode of response
+func (err ResponseStatusError) StatusCode() int {
+	return err.Code
 }
 
 func getHost(fullURL string) (string, error) {
@@ -783,6 +840,9 @@ func newStdRequest(ctx context.Context, request *RestRequest, logEntry *logger.L
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to create HTTP request")
 	}
+	if request.ContentReader != nil && request.ContentLength > 0 {
+		req.ContentLength = request.ContentLength
+	}
 	addCustomHeaders(req, logEntry)
 	//Set user-agent as special "gopherciser version". version is set from the version package during build.
 	req.Header.Set("User-Agent", globals.UserAgent())
@@ -840,7 +900,14 @@ func (transport *Transport) RoundTrip(req *http.Request) (*http.Response, error)
 	body := !isApp && reqSize <= constant.MaxBodySize // avoid logging large bodies
 	LogTrafficOut(req, body, transport.trafficLogger, transport.LogEntry, requestID)
 
+	var sessionTrace *tracing.SessionTrace
+	if transport.State != nil {
+		sessionTrace = transport.Trace
+	}
+	req, endSpan := sessionTrace.StartRequest(req)
+
 	resp, err := transport.Transport.RoundTrip(req)
+	endSpan(resp, err)
 	if err != nil || resp == nil {
 		logErrors(errors.Wrapf(err, "failed to perform HTTP request<%s>", req.URL))
 		return resp, err
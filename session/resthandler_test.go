@@ -3,8 +3,10 @@ package session
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -63,6 +65,30 @@ func TestResthandler(t *testing.T) {
 	assert.Equal(t, "data!", string(postRequest.Content))
 }
 
+func TestPostReaderSync(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		if _, err := fmt.Fprintf(w, "%d;%s", r.ContentLength, body); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer ts.Close()
+
+	actionState := action.State{}
+	pendingHandler := pending.NewHandler()
+
+	restHandler := NewRestHandler(context.Background(), &enigmahandlers.TrafficLogger{}, NewHeaderJar(), "", 10*time.Second, &pendingHandler, &requestmetrics.RequestMetrics{})
+	restHandler.Client = http.DefaultClient
+
+	content := "streamed data!"
+	req, err := restHandler.PostReaderSync(ts.URL, &actionState, &logger.LogEntry{}, io.NopCloser(strings.NewReader(content)), int64(len(content)), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("%d;%s", len(content), content), string(req.ResponseBody))
+}
+
 func TestReqOptions(t *testing.T) {
 	options := DefaultReqOptions()
 	defaultReqOptions.ExpectedStatusCode[0] = 404
@@ -23,6 +23,7 @@ import (
 	"github.com/qlik-oss/gopherciser/senseobjects"
 	"github.com/qlik-oss/gopherciser/statistics"
 	"github.com/qlik-oss/gopherciser/synced"
+	"github.com/qlik-oss/gopherciser/tracing"
 	"github.com/qlik-oss/gopherciser/users"
 	"github.com/qlik-oss/gopherciser/wsdialer"
 )
@@ -84,6 +85,8 @@ type (
 		RequestMetrics     *requestmetrics.RequestMetrics
 		ReconnectSettings  ReconnectSettings
 		Features           Features
+		// Trace of current session iteration, nil when tracing is disabled
+		Trace *tracing.SessionTrace
 
 		rand          *rand
 		trafficLogger enigmahandlers.ITrafficLogger
@@ -12,7 +12,7 @@ type (
 		name    string
 		label   string
 		appGuid string
-		// RespAvg average response time for successful actions
+		// RespAvg response time distribution for successful actions
 		RespAvg *SampleCollector
 		// Requests total count of requests sent within action
 		Requests atomichandlers.AtomicCounter
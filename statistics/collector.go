@@ -14,7 +14,11 @@ type (
 	Collector struct {
 		Actions      ActionStatsMap
 		RestRequests RequestStatsMap
-		Level        StatsLevel
+		// EngineMethods statistics per engine method
+		EngineMethods EngineStatsMap
+		// Errors statistics per error fingerprint
+		Errors ErrorStatsMap
+		Level  StatsLevel
 
 		// totOpenedApps increases each time an app is opened
 		totOpenedApps atomichandlers.AtomicCounter
@@ -23,6 +27,8 @@ type (
 
 		actionsLock  sync.RWMutex
 		requestsLock sync.RWMutex
+		engineLock   sync.RWMutex
+		errorsLock   sync.RWMutex
 	}
 )
 
@@ -36,10 +42,14 @@ const (
 // NewCollector of statistics
 func NewCollector() *Collector {
 	return &Collector{
-		Actions:      make(ActionStatsMap),
-		RestRequests: make(RequestStatsMap),
-		actionsLock:  sync.RWMutex{},
-		requestsLock: sync.RWMutex{},
+		Actions:       make(ActionStatsMap),
+		RestRequests:  make(RequestStatsMap),
+		EngineMethods: make(EngineStatsMap),
+		Errors:        make(ErrorStatsMap),
+		actionsLock:   sync.RWMutex{},
+		requestsLock:  sync.RWMutex{},
+		engineLock:    sync.RWMutex{},
+		errorsLock:    sync.RWMutex{},
 	}
 }
 
@@ -109,6 +119,68 @@ func (collector *Collector) readRequestWithKey(key string) *RequestStats {
 	return collector.RestRequests[key]
 }
 
+// GetOrAddEngineStats from engine method map, returns nil if statistics is turned off
+func (collector *Collector) GetOrAddEngineStats(method string) *EngineStats {
+	if collector == nil || !collector.IsOn() {
+		return nil
+	}
+
+	// Read with Read lock as multiple reader can acquire read lock simultaneously
+	if stats := collector.readEngineWithKey(method); stats != nil {
+		return stats
+	}
+
+	// method not yet registered, acquire write lock and add
+	defer collector.engineLock.Unlock()
+	collector.engineLock.Lock()
+
+	// check if other thread has registered method before we acquired write lock
+	if stats, ok := collector.EngineMethods[method]; ok {
+		return stats
+	}
+
+	stats := NewEngineStats(method)
+	collector.EngineMethods[method] = stats
+	return stats
+}
+
+func (collector *Collector) readEngineWithKey(key string) *EngineStats {
+	defer collector.engineLock.RUnlock()
+	collector.engineLock.RLock()
+	return collector.EngineMethods[key]
+}
+
+// GetOrAddErrorStats from error fingerprint map, returns nil if statistics is turned off
+func (collector *Collector) GetOrAddErrorStats(fingerprint, category, code, message string) *ErrorStats {
+	if collector == nil || !collector.IsOn() {
+		return nil
+	}
+
+	// Read with Read lock as multiple reader can acquire read lock simultaneously
+	if stats := collector.readErrorWithKey(fingerprint); stats != nil {
+		return stats
+	}
+
+	// fingerprint not yet registered, acquire write lock and add
+	defer collector.errorsLock.Unlock()
+	collector.errorsLock.Lock()
+
+	// check if other thread has registered fingerprint before we acquired write lock
+	if stats, ok := collector.Errors[fingerprint]; ok {
+		return stats
+	}
+
+	stats := NewErrorStats(fingerprint, category, code, message)
+	collector.Errors[fingerprint] = stats
+	return stats
+}
+
+func (collector *Collector) readErrorWithKey(key string) *ErrorStats {
+	defer collector.errorsLock.RUnlock()
+	collector.errorsLock.RLock()
+	return collector.Errors[key]
+}
+
 // SetLevel of statistics collected
 func (collector *Collector) SetLevel(level StatsLevel) error {
 	if collector == nil {
@@ -154,6 +226,32 @@ func (collector *Collector) ForEachRequest(f func(stats *RequestStats)) {
 	}
 }
 
+// ForEachEngineMethod read lock map and execute function for each EngineStats entry
+func (collector *Collector) ForEachEngineMethod(f func(stats *EngineStats)) {
+	if collector == nil {
+		return
+	}
+	defer collector.engineLock.RUnlock()
+	collector.engineLock.RLock()
+
+	for _, stats := range collector.EngineMethods {
+		f(stats)
+	}
+}
+
+// ForEachError read lock map and execute function for each ErrorStats entry
+func (collector *Collector) ForEachError(f func(stats *ErrorStats)) {
+	if collector == nil {
+		return
+	}
+	defer collector.errorsLock.RUnlock()
+	collector.errorsLock.RLock()
+
+	for _, stats := range collector.Errors {
+		f(stats)
+	}
+}
+
 // ActionsLen length of action stats map of collector
 func (collector *Collector) ActionsLen() int {
 	if collector == nil {
@@ -170,6 +268,22 @@ func (collector *Collector) RESTRequestLen() int {
 	return len(collector.RestRequests)
 }
 
+// EngineMethodsLen length of engine method stats map of collector
+func (collector *Collector) EngineMethodsLen() int {
+	if collector == nil {
+		return 0
+	}
+	return len(collector.EngineMethods)
+}
+
+// ErrorsLen length of error fingerprint stats map of collector
+func (collector *Collector) ErrorsLen() int {
+	if collector == nil {
+		return 0
+	}
+	return len(collector.Errors)
+}
+
 // OpenedApps total opened apps counted
 func (collector *Collector) OpenedApps() uint64 {
 	if collector == nil {
@@ -0,0 +1,36 @@
+package statistics
+
+import "github.com/qlik-oss/gopherciser/atomichandlers"
+
+type (
+	EngineStatsMap map[string]*EngineStats
+
+	// EngineStats statistics collector for an engine method
+	EngineStats struct {
+		method string
+		// RespAvg response time distribution of requests
+		RespAvg *SampleCollector
+		// Errors total amount of requests responded to with an error
+		Errors atomichandlers.AtomicCounter
+		// Sent total amount of sent bytes
+		Sent atomichandlers.AtomicCounter
+		// Received total amount of received bytes
+		Received atomichandlers.AtomicCounter
+	}
+)
+
+// NewEngineStats creates a new engine method statistics collector
+func NewEngineStats(method string) *EngineStats {
+	return &EngineStats{
+		method:  method,
+		RespAvg: NewSampleCollector(),
+	}
+}
+
+// Method of engine request
+func (engine *EngineStats) Method() string {
+	if engine == nil {
+		return ""
+	}
+	return engine.method
+}
@@ -0,0 +1,105 @@
+package statistics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/qlik-oss/gopherciser/atomichandlers"
+)
+
+type (
+	ErrorStatsMap map[string]*ErrorStats
+
+	// ErrorStats statistics collector for errors with the same fingerprint
+	ErrorStats struct {
+		fingerprint string
+		category    string
+		code        string
+		message     string
+		// Count total amount of errors with fingerprint
+		Count atomichandlers.AtomicCounter
+
+		mu    sync.Mutex
+		first time.Time
+		last  time.Time
+	}
+)
+
+// NewErrorStats creates a new error statistics collector for fingerprint
+func NewErrorStats(fingerprint, category, code, message string) *ErrorStats {
+	return &ErrorStats{
+		fingerprint: fingerprint,
+		category:    category,
+		code:        code,
+		message:     message,
+	}
+}
+
+// Add occurrence of error at time t
+func (stats *ErrorStats) Add(t time.Time) {
+	if stats == nil {
+		return
+	}
+	stats.Count.Inc()
+
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+	if stats.first.IsZero() || t.Before(stats.first) {
+		stats.first = t
+	}
+	if t.After(stats.last) {
+		stats.last = t
+	}
+}
+
+// Fingerprint of error
+func (stats *ErrorStats) Fingerprint() string {
+	if stats == nil {
+		return ""
+	}
+	return stats.fingerprint
+}
+
+// Category of error
+func (stats *ErrorStats) Category() string {
+	if stats == nil {
+		return ""
+	}
+	return stats.category
+}
+
+// Code of error, e.g. engine error code or HTTP status code
+func (stats *ErrorStats) Code() string {
+	if stats == nil {
+		return ""
+	}
+	return stats.code
+}
+
+// Message of first error with fingerprint, with variable parts such as IDs replaced
+func (stats *ErrorStats) Message() string {
+	if stats == nil {
+		return ""
+	}
+	return stats.message
+}
+
+// First occurrence of error
+func (stats *ErrorStats) First() time.Time {
+	if stats == nil {
+		return time.Time{}
+	}
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+	return stats.first
+}
+
+// Last occurrence of error
+func (stats *ErrorStats) Last() time.Time {
+	if stats == nil {
+		return time.Time{}
+	}
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+	return stats.last
+}
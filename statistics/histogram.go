@@ -0,0 +1,300 @@
+package statistics
+
+import (
+	"math"
+	"math/bits"
+	"sort"
+
+	"github.com/goccy/go-json"
+	"github.com/pkg/errors"
+)
+
+type (
+	// Histogram is a mergeable log-linear histogram of uint64 samples. Values are grouped in buckets with a relative
+	// error of at most 1/histSubBuckets, which keeps memory usage independent of the amount of samples while still
+	// giving accurate percentiles. Histogram is not thread safe, use SampleCollector for concurrent use.
+	Histogram struct {
+		buckets map[int]uint64
+		count   uint64
+		sum     float64
+		min     uint64
+		max     uint64
+	}
+
+	// histogramJSON is the serialized form of Histogram
+	histogramJSON struct {
+		Buckets map[int]uint64 `json:"buckets"`
+		Count   uint64         `json:"count"`
+		Sum     float64        `json:"sum"`
+		Min     uint64         `json:"min"`
+		Max     uint64         `json:"max"`
+	}
+
+	// Percentiles snapshot of the most commonly used percentiles of a Histogram
+	Percentiles struct {
+		P50 uint64 `json:"p50"`
+		P90 uint64 `json:"p90"`
+		P95 uint64 `json:"p95"`
+		P99 uint64 `json:"p99"`
+		Max uint64 `json:"max"`
+	}
+)
+
+const (
+	// histSubBucketBits amount of bits used for the linear sub buckets, 7 bits gives a max relative error of ~1.6%
+	histSubBucketBits = 7
+	histSubBuckets    = 1 << histSubBucketBits
+	histHalfBuckets   = histSubBuckets >> 1
+)
+
+// NewHistogram creates an empty histogram
+func NewHistogram() *Histogram {
+	return &Histogram{
+		buckets: make(map[int]uint64),
+	}
+}
+
+// histBucketIndex returns bucket index for value
+func histBucketIndex(v uint64) int {
+	if v < histSubBuckets {
+		return int(v)
+	}
+	shift := bits.Len64(v) - histSubBucketBits
+	return histHalfBuckets*shift + int(v>>uint(shift))
+}
+
+// histBucketLowerBound returns the lowest value which is put in bucket with index
+func histBucketLowerBound(index int) uint64 {
+	if index < histSubBuckets {
+		return uint64(index)
+	}
+	shift := index/histHalfBuckets - 1
+	return uint64(index-histHalfBuckets*shift) << uint(shift)
+}
+
+// histBucketUpperBound returns the highest value which is put in bucket with index
+func histBucketUpperBound(index int) uint64 {
+	if index >= histBucketIndex(math.MaxUint64) {
+		return math.MaxUint64
+	}
+	return histBucketLowerBound(index+1) - 1
+}
+
+// Add sample to histogram
+func (hist *Histogram) Add(v uint64) {
+	hist.AddN(v, 1)
+}
+
+// AddN adds n samples of value v to histogram
+func (hist *Histogram) AddN(v, n uint64) {
+	if hist == nil || n < 1 {
+		return
+	}
+	if hist.buckets == nil {
+		hist.buckets = make(map[int]uint64)
+	}
+	if hist.count == 0 || v < hist.min {
+		hist.min = v
+	}
+	if v > hist.max {
+		hist.max = v
+	}
+	hist.buckets[histBucketIndex(v)] += n
+	hist.count += n
+	hist.sum += float64(v) * float64(n)
+}
+
+// Merge other histogram into histogram
+func (hist *Histogram) Merge(other *Histogram) {
+	if hist == nil || other == nil || other.count < 1 {
+		return
+	}
+	if hist.buckets == nil {
+		hist.buckets = make(map[int]uint64, len(other.buckets))
+	}
+	if hist.count == 0 || other.min < hist.min {
+		hist.min = other.min
+	}
+	if other.max > hist.max {
+		hist.max = other.max
+	}
+	for k, v := range other.buckets {
+		hist.buckets[k] += v
+	}
+	hist.count += other.count
+	hist.sum += other.sum
+}
+
+// Copy creates a deep copy of histogram
+func (hist *Histogram) Copy() *Histogram {
+	cp := NewHistogram()
+	cp.Merge(hist)
+	return cp
+}
+
+// Sub returns a new histogram with the samples of hist not in other, where other is an earlier copy of hist. Min and
+// max of the result are approximated by the bounds of the remaining buckets.
+func (hist *Histogram) Sub(other *Histogram) *Histogram {
+	diff := NewHistogram()
+	if hist == nil {
+		return diff
+	}
+	for k, v := range hist.buckets {
+		if other != nil {
+			if v <= other.buckets[k] {
+				continue
+			}
+			v -= other.buckets[k]
+		}
+		lower, upper := histBucketLowerBound(k), histBucketUpperBound(k)
+		if lower < hist.min {
+			lower = hist.min
+		}
+		if upper > hist.max {
+			upper = hist.max
+		}
+		if diff.count == 0 || lower < diff.min {
+			diff.min = lower
+		}
+		if upper > diff.max {
+			diff.max = upper
+		}
+		diff.buckets[k] = v
+		diff.count += v
+	}
+	diff.sum = hist.sum
+	if other != nil {
+		diff.sum -= other.sum
+	}
+	if diff.count < 1 || diff.sum < 0 {
+		diff.sum = 0
+	}
+	return diff
+}
+
+// Reset removes all samples from histogram
+func (hist *Histogram) Reset() {
+	if hist == nil {
+		return
+	}
+	hist.buckets = make(map[int]uint64)
+	hist.count = 0
+	hist.sum = 0
+	hist.min = 0
+	hist.max = 0
+}
+
+// Count of samples in histogram
+func (hist *Histogram) Count() uint64 {
+	if hist == nil {
+		return 0
+	}
+	return hist.count
+}
+
+// Mean of samples in histogram
+func (hist *Histogram) Mean() float64 {
+	if hist == nil || hist.count < 1 {
+		return 0
+	}
+	return hist.sum / float64(hist.count)
+}
+
+// Min sample value in histogram
+func (hist *Histogram) Min() uint64 {
+	if hist == nil {
+		return 0
+	}
+	return hist.min
+}
+
+// Max sample value in histogram
+func (hist *Histogram) Max() uint64 {
+	if hist == nil {
+		return 0
+	}
+	return hist.max
+}
+
+// Percentile returns the value at percentile p (0-100). The returned value is the upper bound of the bucket
+// containing the percentile, capped by the max value seen.
+func (hist *Histogram) Percentile(p float64) uint64 {
+	if hist == nil || hist.count < 1 {
+		return 0
+	}
+	switch {
+	case p <= 0:
+		return hist.min
+	case p >= 100:
+		return hist.max
+	}
+
+	target := uint64(math.Ceil(p / 100 * float64(hist.count)))
+	if target < 1 {
+		target = 1
+	}
+
+	indices := make([]int, 0, len(hist.buckets))
+	for k := range hist.buckets {
+		indices = append(indices, k)
+	}
+	sort.Ints(indices)
+
+	var seen uint64
+	for _, idx := range indices {
+		seen += hist.buckets[idx]
+		if seen >= target {
+			v := histBucketUpperBound(idx)
+			if v > hist.max {
+				return hist.max
+			}
+			if v < hist.min {
+				return hist.min
+			}
+			return v
+		}
+	}
+	return hist.max
+}
+
+// Percentiles returns p50, p90, p95, p99 and max of histogram
+func (hist *Histogram) Percentiles() Percentiles {
+	return Percentiles{
+		P50: hist.Percentile(50),
+		P90: hist.Percentile(90),
+		P95: hist.Percentile(95),
+		P99: hist.Percentile(99),
+		Max: hist.Max(),
+	}
+}
+
+// MarshalJSON implements Marshaler interface
+func (hist *Histogram) MarshalJSON() ([]byte, error) {
+	if hist == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(histogramJSON{
+		Buckets: hist.buckets,
+		Count:   hist.count,
+		Sum:     hist.sum,
+		Min:     hist.min,
+		Max:     hist.max,
+	})
+}
+
+// UnmarshalJSON implements Unmarshaler interface
+func (hist *Histogram) UnmarshalJSON(arg []byte) error {
+	var h histogramJSON
+	if err := json.Unmarshal(arg, &h); err != nil {
+		return errors.Wrap(err, "failed to unmarshal histogram")
+	}
+	hist.buckets = h.Buckets
+	if hist.buckets == nil {
+		hist.buckets = make(map[int]uint64)
+	}
+	hist.count = h.Count
+	hist.sum = h.Sum
+	hist.min = h.Min
+	hist.max = h.Max
+	return nil
+}
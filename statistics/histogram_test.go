@@ -0,0 +1,126 @@
+package statistics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/goccy/go-json"
+)
+
+func TestHistogram_BucketBounds(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []uint64{0, 1, 127, 128, 129, 255, 256, 1000, 123456789, math.MaxUint64 >> 1, math.MaxUint64} {
+		idx := histBucketIndex(v)
+		lower, upper := histBucketLowerBound(idx), histBucketUpperBound(idx)
+		if v < lower || v > upper {
+			t.Errorf("value<%d> not within bucket<%d> bounds<%d-%d>", v, idx, lower, upper)
+		}
+	}
+}
+
+func TestHistogram_Percentile(t *testing.T) {
+	t.Parallel()
+
+	hist := NewHistogram()
+	for i := uint64(1); i <= 10000; i++ {
+		hist.Add(i * 1000)
+	}
+
+	if hist.Count() != 10000 {
+		t.Fatalf("count<%d> expected<10000>", hist.Count())
+	}
+	if hist.Max() != 10000000 {
+		t.Errorf("max<%d> expected<10000000>", hist.Max())
+	}
+	if hist.Min() != 1000 {
+		t.Errorf("min<%d> expected<1000>", hist.Min())
+	}
+
+	for _, p := range []float64{50, 90, 95, 99} {
+		expected := p * 100 * 1000
+		got := float64(hist.Percentile(p))
+		if math.Abs(got-expected)/expected > 1.0/histHalfBuckets {
+			t.Errorf("p%.0f<%.0f> not within error margin of expected<%.0f>", p, got, expected)
+		}
+	}
+
+	if mean := hist.Mean(); math.Abs(mean-5000500) > 0.001 {
+		t.Errorf("mean<%f> expected<5000500>", mean)
+	}
+}
+
+func TestHistogram_Merge(t *testing.T) {
+	t.Parallel()
+
+	a, b, all := NewHistogram(), NewHistogram(), NewHistogram()
+	for i := uint64(0); i < 1000; i++ {
+		a.Add(i)
+		b.Add(i * 1000)
+		all.Add(i)
+		all.Add(i * 1000)
+	}
+	a.Merge(b)
+
+	if a.Count() != all.Count() || a.Max() != all.Max() || a.Min() != all.Min() {
+		t.Fatalf("merged histogram count<%d> min<%d> max<%d> expected count<%d> min<%d> max<%d>",
+			a.Count(), a.Min(), a.Max(), all.Count(), all.Min(), all.Max())
+	}
+	if a.Percentiles() != all.Percentiles() {
+		t.Errorf("merged percentiles<%+v> expected<%+v>", a.Percentiles(), all.Percentiles())
+	}
+}
+
+func TestHistogram_JSON(t *testing.T) {
+	t.Parallel()
+
+	hist := NewHistogram()
+	for i := uint64(0); i < 5000; i++ {
+		hist.Add(i * i)
+	}
+
+	raw, err := json.Marshal(hist)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var unmarshaled Histogram
+	if err := json.Unmarshal(raw, &unmarshaled); err != nil {
+		t.Fatal(err)
+	}
+
+	if unmarshaled.Percentiles() != hist.Percentiles() || unmarshaled.Count() != hist.Count() {
+		t.Errorf("unmarshaled histogram<%+v> differs from original<%+v>", unmarshaled.Percentiles(), hist.Percentiles())
+	}
+}
+
+func TestHistogram_Sub(t *testing.T) {
+	t.Parallel()
+
+	hist := NewHistogram()
+	for i := uint64(1); i <= 100; i++ {
+		hist.Add(i)
+	}
+	snapshot := hist.Copy()
+	for i := uint64(1); i <= 100; i++ {
+		hist.Add(i * 1000)
+	}
+
+	diff := hist.Sub(snapshot)
+	if diff.Count() != 100 {
+		t.Fatalf("count<%d> expected<100>", diff.Count())
+	}
+	if mean := diff.Mean(); math.Abs(mean-50500) > 0.001 {
+		t.Errorf("mean<%f> expected<50500>", mean)
+	}
+	if diff.Min() > 1000 || diff.Max() != 100000 {
+		t.Errorf("min<%d> max<%d> expected within<1000-100000>", diff.Min(), diff.Max())
+	}
+	if p95 := float64(diff.Percentile(95)); math.Abs(p95-95000)/95000 > 1.0/histHalfBuckets {
+		t.Errorf("p95<%.0f> not within error margin of expected<95000>", p95)
+	}
+
+	if empty := hist.Sub(hist.Copy()); empty.Count() != 0 || empty.Mean() != 0 {
+		t.Errorf("expected empty histogram, got count<%d> mean<%f>", empty.Count(), empty.Mean())
+	}
+}
@@ -0,0 +1,153 @@
+package statistics
+
+import (
+	"time"
+)
+
+type (
+	// IntervalEntry aggregated statistics for one interval, response times are in nanoseconds
+	IntervalEntry struct {
+		Timestamp   time.Time `json:"timestamp"`
+		Interval    float64   `json:"interval"` // interval length in seconds
+		Type        string    `json:"type"`
+		Action      string    `json:"action,omitempty"`
+		Label       string    `json:"label,omitempty"`
+		AppGUID     string    `json:"appGUID,omitempty"`
+		Actions     uint64    `json:"actions"`
+		Failed      uint64    `json:"failed"`
+		Errors      uint64    `json:"errors"`
+		Warnings    uint64    `json:"warnings"`
+		Requests    uint64    `json:"requests"`
+		Sent        uint64    `json:"sent"`
+		Received    uint64    `json:"received"`
+		ActiveUsers uint64    `json:"activeUsers"`
+		Throughput  float64   `json:"throughput"` // actions per second
+		AvgResp     float64   `json:"avgResp"`
+		Percentiles
+	}
+
+	// IntervalCollector calculates per interval statistics from execution counters
+	IntervalCollector struct {
+		counters    *ExecutionCounters
+		last        time.Time
+		prevTotals  intervalCounts
+		prevActions map[*ActionStats]intervalCounts
+	}
+
+	intervalCounts struct {
+		failed   uint64
+		errors   uint64
+		warnings uint64
+		requests uint64
+		sent     uint64
+		received uint64
+		actions  uint64
+	}
+)
+
+const (
+	// IntervalTypeTotal interval entry with totals for all actions
+	IntervalTypeTotal = "total"
+	// IntervalTypeAction interval entry for an unique combination of action, label and app GUID
+	IntervalTypeAction = "action"
+)
+
+// NewIntervalCollector creates an interval collector with first interval starting at start
+func NewIntervalCollector(counters *ExecutionCounters, start time.Time) *IntervalCollector {
+	return &IntervalCollector{
+		counters:    counters,
+		last:        start,
+		prevActions: make(map[*ActionStats]intervalCounts),
+	}
+}
+
+// Collect statistics for interval since last collect, returns total entry followed by entries for actions with
+// activity during interval
+func (ic *IntervalCollector) Collect(now time.Time) []IntervalEntry {
+	seconds := now.Sub(ic.last).Seconds()
+	ic.last = now
+
+	totals := intervalCounts{
+		errors:   ic.counters.Errors.Current(),
+		warnings: ic.counters.Warnings.Current(),
+		requests: ic.counters.Requests.Current(),
+	}
+
+	totalHist := NewHistogram()
+	actionEntries := make([]IntervalEntry, 0, ic.counters.StatisticsCollector.ActionsLen())
+
+	ic.counters.StatisticsCollector.ForEachAction(func(stats *ActionStats) {
+		hist := stats.RespAvg.PopInterval()
+		totalHist.Merge(hist)
+
+		current := intervalCounts{
+			failed:   stats.Failed.Current(),
+			errors:   stats.ErrCount.Current(),
+			warnings: stats.WarnCount.Current(),
+			requests: stats.Requests.Current(),
+			sent:     stats.Sent.Current(),
+			received: stats.Received.Current(),
+		}
+		prev := ic.prevActions[stats]
+		ic.prevActions[stats] = current
+
+		totals.failed += current.failed
+		totals.sent += current.sent
+		totals.received += current.received
+
+		delta := current.sub(prev)
+		delta.actions = hist.Count() + delta.failed
+		if delta == (intervalCounts{}) {
+			return
+		}
+
+		entry := newIntervalEntry(now, seconds, IntervalTypeAction, delta, hist)
+		entry.Action = stats.Name()
+		entry.Label = stats.Label()
+		entry.AppGUID = stats.AppGUID()
+		actionEntries = append(actionEntries, entry)
+	})
+
+	// count completed actions the same way as for action entries, for totals to equal the sum of action entries
+	delta := totals.sub(ic.prevTotals)
+	delta.actions = totalHist.Count() + delta.failed
+	ic.prevTotals = totals
+
+	total := newIntervalEntry(now, seconds, IntervalTypeTotal, delta, totalHist)
+	total.ActiveUsers = ic.counters.ActiveUsers.Current()
+
+	return append([]IntervalEntry{total}, actionEntries...)
+}
+
+func newIntervalEntry(now time.Time, seconds float64, typ string, delta intervalCounts, hist *Histogram) IntervalEntry {
+	entry := IntervalEntry{
+		Timestamp:   now,
+		Interval:    seconds,
+		Type:        typ,
+		Actions:     delta.actions,
+		Failed:      delta.failed,
+		Errors:      delta.errors,
+		Warnings:    delta.warnings,
+		Requests:    delta.requests,
+		Sent:        delta.sent,
+		Received:    delta.received,
+		AvgResp:     hist.Mean(),
+		Percentiles: hist.Percentiles(),
+	}
+	if seconds > 0 {
+		entry.Throughput = float64(delta.actions) / seconds
+	}
+	return entry
+}
+
+func (counts intervalCounts) sub(prev intervalCounts) intervalCounts {
+	return intervalCounts{
+		failed:   counts.failed - prev.failed,
+		errors:   counts.errors - prev.errors,
+		warnings: counts.warnings - prev.warnings,
+		requests: counts.requests - prev.requests,
+		sent:     counts.sent - prev.sent,
+		received: counts.received - prev.received,
+		actions:  counts.actions - prev.actions,
+	}
+}
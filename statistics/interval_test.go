@@ -0,0 +1,66 @@
+package statistics
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIntervalCollector(t *testing.T) {
+	t.Parallel()
+
+	counters := &ExecutionCounters{StatisticsCollector: NewCollector()}
+	if err := counters.StatisticsCollector.SetLevel(StatsLevelOn); err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	ic := NewIntervalCollector(counters, start)
+
+	openStats := counters.StatisticsCollector.GetOrAddActionStats("openapp", "", "app1")
+	chStats := counters.StatisticsCollector.GetOrAddActionStats("changesheet", "", "app1")
+	for i := 0; i < 10; i++ {
+		openStats.RespAvg.AddSample(uint64(time.Second))
+	}
+	openStats.Failed.Inc()
+	openStats.Requests.Add(50)
+	counters.Requests.Add(50)
+	counters.Errors.Inc()
+	counters.ActiveUsers.Add(3)
+	counters.ActionID.Add(15) // started actions include sub-actions and actions without statistics
+
+	entries := ic.Collect(start.Add(10 * time.Second))
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries in first interval, expected 2", len(entries))
+	}
+	total, open := entries[0], entries[1]
+	if total.Type != IntervalTypeTotal || total.Requests != 50 || total.Errors != 1 || total.ActiveUsers != 3 || total.Failed != 1 || total.Actions != 11 {
+		t.Errorf("unexpected total entry: %+v", total)
+	}
+	if open.Action != "openapp" || open.Actions != 11 || open.Failed != 1 || open.Requests != 50 {
+		t.Errorf("unexpected openapp entry: %+v", open)
+	}
+	if open.Throughput != 1.1 || total.Throughput != open.Throughput {
+		t.Errorf("openapp throughput<%v> total throughput<%v> expected<1.1>", open.Throughput, total.Throughput)
+	}
+	if time.Duration(open.P95) != time.Second {
+		t.Errorf("openapp p95<%v> expected<1s>", time.Duration(open.P95))
+	}
+
+	// second interval, only changesheet has activity
+	for i := 0; i < 5; i++ {
+		chStats.RespAvg.AddSample(uint64(time.Millisecond * 200))
+	}
+	counters.Requests.Add(5)
+
+	entries = ic.Collect(start.Add(20 * time.Second))
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries in second interval, expected 2", len(entries))
+	}
+	total, ch := entries[0], entries[1]
+	if total.Requests != 5 || total.Errors != 0 || total.Failed != 0 || total.Actions != ch.Actions {
+		t.Errorf("unexpected total entry: %+v", total)
+	}
+	if ch.Action != "changesheet" || ch.Actions != 5 || time.Duration(ch.Max) != 200*time.Millisecond {
+		t.Errorf("unexpected changesheet entry: %+v", ch)
+	}
+}
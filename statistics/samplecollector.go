@@ -6,12 +6,13 @@ import (
 )
 
 type (
+	// SampleCollector collects samples into a Histogram using a hot buffer to minimize time spent holding locks
 	SampleCollector struct {
 		hotBuf  []uint64
 		bufLock sync.Mutex
 
-		curAvg         float64
-		count          uint64
+		hist           *Histogram
+		interval       *Histogram
 		bufPurgeExpiry time.Duration
 		bufPurgeTs     time.Time
 	}
@@ -24,15 +25,17 @@ var (
 	DefaultPurgeExpiry = time.Second * 5
 )
 
-// NewSampleCollector for collecting running average
+// NewSampleCollector for collecting sample distribution
 func NewSampleCollector() *SampleCollector {
 	return NewSampleCollectorBuf(DefaultHotBuffer)
 }
 
-// NewSampleCollector for collecting running average with custom hot buffer size
+// NewSampleCollectorBuf for collecting sample distribution with custom hot buffer size
 func NewSampleCollectorBuf(buf int) *SampleCollector {
 	return &SampleCollector{
 		hotBuf:         make([]uint64, 0, buf),
+		hist:           NewHistogram(),
+		interval:       NewHistogram(),
 		bufPurgeExpiry: DefaultPurgeExpiry,
 		bufPurgeTs:     time.Now().Add(DefaultPurgeExpiry),
 	}
@@ -61,31 +64,66 @@ func (collector *SampleCollector) AddSample(sample uint64) {
 
 // emptyHotBuffer should only be called by function holding collector.bufLock
 func (collector *SampleCollector) emptyHotBuffer() {
-	newCount := uint64(len(collector.hotBuf))
-
-	if newCount < 1 {
+	if len(collector.hotBuf) < 1 {
 		// nothing in buffer
 		return
 	}
 
-	var newSum float64
 	for _, v := range collector.hotBuf {
-		newSum += float64(v)
+		collector.hist.Add(v)
+		collector.interval.Add(v)
 	}
 
-	// avoiding (curAvg*count + newAvg*newCount)/(count+newCount) for overflow protection, below equation equates to the same value
-	collector.curAvg = collector.curAvg/(1+float64(newCount)/float64(collector.count)) + newSum/float64(collector.count+newCount)
-	collector.count += newCount
 	collector.hotBuf = collector.hotBuf[0:0]
 	collector.bufPurgeTs = time.Now().Add(collector.bufPurgeExpiry)
 }
 
-// Average empties hot buffer and calculates current running average, returns average, total samples.
+// Average empties hot buffer and calculates current average, returns average, total samples.
 func (collector *SampleCollector) Average() (float64, uint64) {
 	defer collector.bufLock.Unlock()
 	collector.bufLock.Lock()
 
 	collector.emptyHotBuffer()
 
-	return collector.curAvg, collector.count
+	return collector.hist.Mean(), collector.hist.Count()
+}
+
+// Percentiles empties hot buffer and returns percentiles of samples collected, returns percentiles, total samples.
+func (collector *SampleCollector) Percentiles() (Percentiles, uint64) {
+	defer collector.bufLock.Unlock()
+	collector.bufLock.Lock()
+
+	collector.emptyHotBuffer()
+
+	return collector.hist.Percentiles(), collector.hist.Count()
+}
+
+// Histogram empties hot buffer and returns a copy of the current histogram
+func (collector *SampleCollector) Histogram() *Histogram {
+	defer collector.bufLock.Unlock()
+	collector.bufLock.Lock()
+
+	collector.emptyHotBuffer()
+
+	return collector.hist.Copy()
+}
+
+// Merge histogram into collected samples
+func (collector *SampleCollector) Merge(hist *Histogram) {
+	defer collector.bufLock.Unlock()
+	collector.bufLock.Lock()
+
+	collector.hist.Merge(hist)
+}
+
+// PopInterval empties hot buffer and returns histogram of samples collected since last call to PopInterval
+func (collector *SampleCollector) PopInterval() *Histogram {
+	defer collector.bufLock.Unlock()
+	collector.bufLock.Lock()
+
+	collector.emptyHotBuffer()
+
+	interval := collector.interval
+	collector.interval = NewHistogram()
+	return interval
 }
@@ -0,0 +1,255 @@
+package statistics
+
+import (
+	"bytes"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/pkg/errors"
+)
+
+type (
+	// SummaryFile content of summary file written when using summary type file
+	SummaryFile struct {
+		// Version of summary file format, summary files containing only a list of totals are read as version 1
+		Version  int                       `json:"version"`
+		Totals   []SummaryEntry            `json:"totals"`
+		Actions  []SummaryActionFileEntry  `json:"actions"`
+		Requests []SummaryRequestFileEntry `json:"requests,omitempty"`
+		// EngineMethods statistics per engine method
+		EngineMethods []SummaryEngineFileEntry `json:"engineMethods,omitempty"`
+		// Errors grouped by fingerprint
+		Errors []SummaryErrorFileEntry `json:"errors,omitempty"`
+	}
+
+	// SummaryActionFileEntry statistics for an unique combination of action, label and app GUID in summary file.
+	// All response times are in nanoseconds.
+	SummaryActionFileEntry struct {
+		Action      string      `json:"action"`
+		Label       string      `json:"label"`
+		AppGUID     string      `json:"appGUID"`
+		Successful  uint64      `json:"successful"`
+		Failed      uint64      `json:"failed"`
+		AvgResp     float64     `json:"avgResp"`
+		Percentiles Percentiles `json:"percentiles"`
+		Histogram   *Histogram  `json:"histogram,omitempty"`
+		Requests    uint64      `json:"requests"`
+		Errors      uint64      `json:"errors"`
+		Warnings    uint64      `json:"warnings"`
+		Sent        uint64      `json:"sent"`
+		Received    uint64      `json:"received"`
+	}
+
+	// SummaryRequestFileEntry statistics for a REST endpoint in summary file. All response times are in nanoseconds.
+	SummaryRequestFileEntry struct {
+		Method      string      `json:"method"`
+		Path        string      `json:"path"`
+		Requests    uint64      `json:"requests"`
+		AvgResp     float64     `json:"avgResp"`
+		Percentiles Percentiles `json:"percentiles"`
+		Histogram   *Histogram  `json:"histogram,omitempty"`
+		Sent        uint64      `json:"sent"`
+		Received    uint64      `json:"received"`
+	}
+
+	// SummaryEngineFileEntry statistics for an engine method in summary file. All response times are in nanoseconds.
+	SummaryEngineFileEntry struct {
+		Method      string      `json:"method"`
+		Requests    uint64      `json:"requests"`
+		Errors      uint64      `json:"errors"`
+		AvgResp     float64     `json:"avgResp"`
+		Percentiles Percentiles `json:"percentiles"`
+		Histogram   *Histogram  `json:"histogram,omitempty"`
+		Sent        uint64      `json:"sent"`
+		Received    uint64      `json:"received"`
+	}
+
+	// SummaryEntry title and value of a summary total
+	SummaryEntry struct {
+		LongTitle  string `json:"longTitle"`
+		ShortTitle string `json:"shortTitle"`
+		Value      string `json:"value"`
+	}
+
+	// SummaryErrorFileEntry errors with the same fingerprint in summary file
+	SummaryErrorFileEntry struct {
+		Fingerprint string    `json:"fingerprint"`
+		Category    string    `json:"category"`
+		Code        string    `json:"code,omitempty"`
+		Message     string    `json:"message"`
+		Count       uint64    `json:"count"`
+		First       time.Time `json:"first"`
+		Last        time.Time `json:"last"`
+	}
+)
+
+// Summary file format versions
+const (
+	// SummaryFileVersionLegacy summary file containing only a list of totals
+	SummaryFileVersionLegacy = 1
+	// SummaryFileVersion summary file containing totals and statistics per action, request, engine method and error
+	SummaryFileVersion = 2
+)
+
+// SummaryTotals creates summary totals entries, extended adds totals of users, threads, sessions and apps
+func SummaryTotals(extended bool, testDuration time.Duration, counters *ExecutionCounters) []SummaryEntry {
+	totals := []SummaryEntry{
+		{"Total errors", "TotErrors", strconv.FormatUint(counters.Errors.Current(), 10)},
+		{"Total warnings", "TotWarnings", strconv.FormatUint(counters.Warnings.Current(), 10)},
+		{"Total actions", "TotActions", strconv.FormatUint(counters.ActionID.Current(), 10)},
+		{"Total requests", "TotRequests", strconv.FormatUint(counters.Requests.Current(), 10)},
+		{"Duration", "Duration", testDuration.String()},
+	}
+
+	if extended {
+		totals = append(totals, []SummaryEntry{
+			{"Total users", "TotUsers", strconv.FormatUint(counters.Users.Current(), 10)},
+			{"Total threads", "TotThreads", strconv.FormatUint(counters.Threads.Current(), 10)},
+			{"Total sessions", "TotSessions", strconv.FormatUint(counters.Sessions.Current(), 10)},
+			{"Total apps opened", "OpenedApps", strconv.FormatUint(counters.StatisticsCollector.OpenedApps(), 10)},
+			{"Total apps created", "CreatedApps", strconv.FormatUint(counters.StatisticsCollector.CreatedApps(), 10)},
+		}...)
+	}
+
+	return totals
+}
+
+// NewSummaryFile creates summary file content from execution counters
+func NewSummaryFile(testDuration time.Duration, counters *ExecutionCounters) *SummaryFile {
+	collector := counters.StatisticsCollector
+	summaryFile := &SummaryFile{
+		Version:       SummaryFileVersion,
+		Totals:        SummaryTotals(true, testDuration, counters),
+		Actions:       make([]SummaryActionFileEntry, 0, collector.ActionsLen()),
+		Requests:      make([]SummaryRequestFileEntry, 0, collector.RESTRequestLen()),
+		EngineMethods: make([]SummaryEngineFileEntry, 0, collector.EngineMethodsLen()),
+	}
+
+	collector.ForEachAction(func(stats *ActionStats) {
+		hist := stats.RespAvg.Histogram()
+		summaryFile.Actions = append(summaryFile.Actions, SummaryActionFileEntry{
+			Action:      stats.Name(),
+			Label:       stats.Label(),
+			AppGUID:     stats.AppGUID(),
+			Successful:  hist.Count(),
+			Failed:      stats.Failed.Current(),
+			AvgResp:     hist.Mean(),
+			Percentiles: hist.Percentiles(),
+			Histogram:   hist,
+			Requests:    stats.Requests.Current(),
+			Errors:      stats.ErrCount.Current(),
+			Warnings:    stats.WarnCount.Current(),
+			Sent:        stats.Sent.Current(),
+			Received:    stats.Received.Current(),
+		})
+	})
+
+	collector.ForEachRequest(func(stats *RequestStats) {
+		hist := stats.RespAvg.Histogram()
+		summaryFile.Requests = append(summaryFile.Requests, SummaryRequestFileEntry{
+			Method:      stats.Method(),
+			Path:        stats.Path(),
+			Requests:    hist.Count(),
+			AvgResp:     hist.Mean(),
+			Percentiles: hist.Percentiles(),
+			Histogram:   hist,
+			Sent:        stats.Sent.Current(),
+			Received:    stats.Received.Current(),
+		})
+	})
+
+	collector.ForEachEngineMethod(func(stats *EngineStats) {
+		hist := stats.RespAvg.Histogram()
+		summaryFile.EngineMethods = append(summaryFile.EngineMethods, SummaryEngineFileEntry{
+			Method:      stats.Method(),
+			Requests:    hist.Count(),
+			Errors:      stats.Errors.Current(),
+			AvgResp:     hist.Mean(),
+			Percentiles: hist.Percentiles(),
+			Histogram:   hist,
+			Sent:        stats.Sent.Current(),
+			Received:    stats.Received.Current(),
+		})
+	})
+	sort.Slice(summaryFile.EngineMethods, func(i, j int) bool {
+		return summaryFile.EngineMethods[i].Method < summaryFile.EngineMethods[j].Method
+	})
+	summaryFile.Errors = SummaryErrorEntries(collector)
+
+	return summaryFile
+}
+
+// Write summary file as JSON
+func (summaryFile *SummaryFile) Write(fileName string) error {
+	jsn, err := json.Marshal(summaryFile)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal summary file")
+	}
+	return errors.WithStack(os.WriteFile(fileName, jsn, 0644))
+}
+
+// ReadSummaryFile reads summary file written using summary type file, summary files of the legacy format only
+// containing a list of totals are read into totals with version set to SummaryFileVersionLegacy
+func ReadSummaryFile(fileName string) (*SummaryFile, error) {
+	raw, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	raw = bytes.TrimSpace(raw)
+	if len(raw) > 0 && raw[0] == '[' {
+		summaryFile := SummaryFile{Version: SummaryFileVersionLegacy}
+		if err := json.Unmarshal(raw, &summaryFile.Totals); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal legacy summary file<%s>", fileName)
+		}
+		return &summaryFile, nil
+	}
+
+	var summaryFile SummaryFile
+	if err := json.Unmarshal(raw, &summaryFile); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal summary file<%s>", fileName)
+	}
+	if summaryFile.Totals == nil {
+		return nil, errors.Errorf("file<%s> has no summary totals", fileName)
+	}
+	if summaryFile.Version > SummaryFileVersion {
+		return nil, errors.Errorf("summary file<%s> version<%d> not supported, latest supported version<%d>", fileName, summaryFile.Version, SummaryFileVersion)
+	}
+	return &summaryFile, nil
+}
+
+// Total value of summary total entry with short title, returns false if not found
+func (summaryFile *SummaryFile) Total(shortTitle string) (string, bool) {
+	for _, entry := range summaryFile.Totals {
+		if entry.ShortTitle == shortTitle {
+			return entry.Value, true
+		}
+	}
+	return "", false
+}
+
+// SummaryErrorEntries returns errors grouped by fingerprint sorted by count, most common first
+func SummaryErrorEntries(collector *Collector) []SummaryErrorFileEntry {
+	entries := make([]SummaryErrorFileEntry, 0, collector.ErrorsLen())
+	collector.ForEachError(func(stats *ErrorStats) {
+		entries = append(entries, SummaryErrorFileEntry{
+			Fingerprint: stats.Fingerprint(),
+			Category:    stats.Category(),
+			Code:        stats.Code(),
+			Message:     stats.Message(),
+			Count:       stats.Count.Current(),
+			First:       stats.First(),
+			Last:        stats.Last(),
+		})
+	})
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Count != entries[j].Count {
+			return entries[i].Count > entries[j].Count
+		}
+		return entries[i].Fingerprint < entries[j].Fingerprint
+	})
+	return entries
+}
@@ -0,0 +1,41 @@
+package statistics
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadSummaryFile(t *testing.T) {
+	dir := t.TempDir()
+
+	legacyFileName := filepath.Join(dir, "legacy.json")
+	legacy := `[{"longTitle":"Total errors","shortTitle":"TotErrors","value":"2"},{"longTitle":"Total actions","shortTitle":"TotActions","value":"10"}]`
+	if err := os.WriteFile(legacyFileName, []byte(legacy), 0644); err != nil {
+		t.Fatal(err)
+	}
+	summaryFile, err := ReadSummaryFile(legacyFileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summaryFile.Version != SummaryFileVersionLegacy {
+		t.Errorf("legacy summary file version<%d> expected<%d>", summaryFile.Version, SummaryFileVersionLegacy)
+	}
+	if errs, _ := summaryFile.Total("TotErrors"); errs != "2" {
+		t.Errorf("legacy summary file errors<%s> expected<2>", errs)
+	}
+
+	for name, content := range map[string]string{
+		"notsummary.json": `{"some":"object"}`,
+		"future.json":     `{"version":99,"totals":[]}`,
+		"broken.json":     `[{"longTitle":`,
+	} {
+		fileName := filepath.Join(dir, name)
+		if err := os.WriteFile(fileName, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ReadSummaryFile(fileName); err == nil {
+			t.Errorf("expected error reading %s", name)
+		}
+	}
+}
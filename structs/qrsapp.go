@@ -0,0 +1,16 @@
+package structs
+
+type (
+	// QrsApp app as returned by QRS app endpoints
+	QrsApp struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+
+	// QrsAppExport export ticket returned by QRS app export endpoint
+	QrsAppExport struct {
+		ExportToken  string `json:"exportToken"`
+		AppID        string `json:"appId"`
+		DownloadPath string `json:"downloadPath"`
+	}
+)
@@ -42,6 +42,7 @@ var (
 		"add":       add,
 		"join":      strings.Join,
 		"modulo":    modulo,
+		"segment":   func() int { return 0 },
 	}
 )
 
@@ -130,6 +131,29 @@ func (input *Template) ExecuteString(data interface{}) (string, error) {
 	return buf.String(), nil
 }
 
+// ExecuteStringWithSegment execute template with data, where the template function segment returns segment
+func (input *Template) ExecuteStringWithSegment(data interface{}, segment int) (string, error) {
+	if input == nil {
+		return "", errors.New("template is nil")
+	}
+	if err := input.parse(); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	t, err := input.template.Clone()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to clone variables template")
+	}
+	t.Funcs(template.FuncMap{"segment": func() int { return segment }})
+
+	buf := helpers.GlobalBufferPool.Get()
+	defer helpers.GlobalBufferPool.Put(buf)
+	if err := t.Execute(buf, data); err != nil {
+		return "", errors.Wrap(err, "failed to execute variables template")
+	}
+	return buf.String(), nil
+}
+
 func add(iVal1 interface{}, iVal2 interface{}) (int64, error) {
 	val1, err := parseToInt64(iVal1)
 	if err != nil {
@@ -93,3 +93,21 @@ func testParam(t *testing.T, tmpl *Template, expected string) {
 		t.Errorf("unexpected template result<%s> expected<%s>", result, expected)
 	}
 }
+
+func TestTemplateSegment(t *testing.T) {
+	tmpl, err := New(`{{.Val1}}-{{printf "%03d" segment}}.tsv`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testParam(t, tmpl, "val1-000.tsv")
+	result, err := tmpl.ExecuteStringWithSegment(data, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != "val1-002.tsv" {
+		t.Errorf("unexpected template result<%s> expected<val1-002.tsv>", result)
+	}
+	// segment function of original template should be unaffected
+	testParam(t, tmpl, "val1-000.tsv")
+}
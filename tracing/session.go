@@ -0,0 +1,226 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// SessionTrace trace of a simulated session. All methods are safe to use on a nil SessionTrace, which is what
+	// StartSession returns when tracing is disabled.
+	SessionTrace struct {
+		tracer trace.Tracer
+
+		mu   sync.Mutex
+		ctx  context.Context
+		span trace.Span
+		// actions stack of started actions, sub-actions of container actions becomes children of the container action
+		actions []actionSpan
+	}
+
+	actionSpan struct {
+		ctx  context.Context
+		span trace.Span
+	}
+
+	// EngineRequestEnd ends span of engine request with timestamps and message sizes as measured by the caller
+	EngineRequestEnd func(start, end time.Time, sent, received int, err error)
+)
+
+// StartSession starts a new trace for a simulated session, returns nil if tracing is disabled
+func StartSession(session, thread uint64, user string) *SessionTrace {
+	tracer := currentTracer()
+	if tracer == nil {
+		return nil
+	}
+
+	ctx, span := tracer.Start(context.Background(), "session",
+		trace.WithNewRoot(),
+		trace.WithAttributes(
+			attribute.Int64("gopherciser.session", int64(session)),
+			attribute.Int64("gopherciser.thread", int64(thread)),
+			attribute.String("gopherciser.user", user),
+		),
+	)
+
+	return &SessionTrace{
+		tracer: tracer,
+		ctx:    ctx,
+		span:   span,
+	}
+}
+
+// End session trace, any actions still started are ended first
+func (st *SessionTrace) End(err error) {
+	if st == nil {
+		return
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	for i := len(st.actions) - 1; i >= 0; i-- {
+		st.actions[i].span.End()
+	}
+	st.actions = nil
+
+	setStatus(st.span, err)
+	st.span.End()
+}
+
+// StartAction starts an action span as child of the session or of the current container action
+func (st *SessionTrace) StartAction(action, label string, actionID uint64) {
+	if st == nil {
+		return
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	ctx, span := st.tracer.Start(st.currentContext(), action,
+		trace.WithAttributes(
+			attribute.String("gopherciser.action", action),
+			attribute.String("gopherciser.label", label),
+			attribute.Int64("gopherciser.actionid", int64(actionID)),
+		),
+	)
+	st.actions = append(st.actions, actionSpan{ctx: ctx, span: span})
+}
+
+// EndAction ends the latest started action span
+func (st *SessionTrace) EndAction(success bool, err error) {
+	if st == nil {
+		return
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if len(st.actions) < 1 {
+		return
+	}
+	current := st.actions[len(st.actions)-1]
+	st.actions = st.actions[:len(st.actions)-1]
+
+	current.span.SetAttributes(attribute.Bool("gopherciser.success", success))
+	if !success && err == nil {
+		current.span.SetStatus(codes.Error, "action failed")
+	} else {
+		setStatus(current.span, err)
+	}
+	current.span.End()
+}
+
+// Context with current action span, or session span if no action is started
+func (st *SessionTrace) Context() context.Context {
+	if st == nil {
+		return context.Background()
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return st.currentContext()
+}
+
+func (st *SessionTrace) currentContext() context.Context {
+	if len(st.actions) > 0 {
+		return st.actions[len(st.actions)-1].ctx
+	}
+	return st.ctx
+}
+
+// StartEngineRequest captures current action as parent of an engine RPC span. The span is created when the returned
+// function is executed, using the measured timestamps of the request. Returns nil if tracing is disabled.
+func (st *SessionTrace) StartEngineRequest(method, objectID string, handle int) EngineRequestEnd {
+	if st == nil {
+		return nil
+	}
+	parent := st.Context()
+
+	return func(start, end time.Time, sent, received int, err error) {
+		if start.IsZero() {
+			start = time.Now()
+		}
+		_, span := st.tracer.Start(parent, method,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithTimestamp(start),
+			trace.WithAttributes(
+				attribute.String("rpc.system", "qix"),
+				attribute.String("rpc.method", method),
+				attribute.Int("gopherciser.handle", handle),
+				attribute.Int("gopherciser.sent", sent),
+				attribute.Int("gopherciser.received", received),
+			),
+		)
+		if objectID != "" {
+			span.SetAttributes(attribute.String("gopherciser.objectid", objectID))
+		}
+		setStatus(span, err)
+		if end.IsZero() {
+			end = time.Now()
+		}
+		span.End(trace.WithTimestamp(end))
+	}
+}
+
+// StartRequest starts a span for a REST request. Returned request is a copy of req with W3C traceparent header
+// added, the returned function ends the span.
+func (st *SessionTrace) StartRequest(req *http.Request) (*http.Request, func(resp *http.Response, err error)) {
+	if st == nil || req == nil {
+		return req, func(resp *http.Response, err error) {}
+	}
+
+	path := ""
+	if req.URL != nil {
+		path = req.URL.Path
+	}
+	ctx, span := st.tracer.Start(st.Context(), req.Method+" "+path,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", req.Method),
+			attribute.String("url.path", path),
+		),
+	)
+	if req.URL != nil {
+		span.SetAttributes(attribute.String("server.address", req.URL.Host))
+	}
+
+	tracedReq := req.Clone(req.Context())
+	propagator.Inject(ctx, propagation.HeaderCarrier(tracedReq.Header))
+
+	return tracedReq, func(resp *http.Response, err error) {
+		if resp != nil {
+			span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+			if err == nil && resp.StatusCode >= http.StatusBadRequest {
+				span.SetStatus(codes.Error, resp.Status)
+			}
+		}
+		setStatus(span, err)
+		span.End()
+	}
+}
+
+// InjectHeaders returns a copy of header with W3C traceparent of current action added, e.g. to be used for
+// websocket upgrade request. Returns header as is if tracing is disabled.
+func (st *SessionTrace) InjectHeaders(header http.Header) http.Header {
+	if st == nil {
+		return header
+	}
+	injected := header.Clone()
+	if injected == nil {
+		injected = make(http.Header)
+	}
+	propagator.Inject(st.Context(), propagation.HeaderCarrier(injected))
+	return injected
+}
+
+func setStatus(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -0,0 +1,114 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/qlik-oss/gopherciser/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// Settings for exporting traces using OTLP over HTTP
+	Settings struct {
+		// Endpoint of OTLP collector, either host:port or a full URL. Tracing is disabled if empty.
+		Endpoint string
+		// Insecure use HTTP instead of HTTPS when endpoint is defined as host:port
+		Insecure bool
+		// ServiceName reported as resource service.name, defaults to DefaultServiceName
+		ServiceName string
+		// SampleRatio ratio of sessions to trace, 0 defaults to all sessions
+		SampleRatio float64
+	}
+
+	tracerHolder struct {
+		tracer trace.Tracer
+	}
+)
+
+const (
+	// DefaultServiceName service name used when none is defined
+	DefaultServiceName = "gopherciser"
+
+	instrumentationName = "github.com/qlik-oss/gopherciser/tracing"
+)
+
+var (
+	activeTracer atomic.Pointer[tracerHolder]
+
+	// propagator used for traceparent headers on REST requests and websocket upgrade
+	propagator = propagation.TraceContext{}
+)
+
+// Setup starts exporting traces according to settings. Returned function flushes remaining spans and stops
+// tracing, it's safe to use also when tracing was not enabled.
+func Setup(ctx context.Context, settings Settings) (func(ctx context.Context) error, error) {
+	if settings.Endpoint == "" {
+		return func(ctx context.Context) error { return nil }, nil
+	}
+
+	if settings.SampleRatio < 0 || settings.SampleRatio > 1 {
+		return nil, errors.Errorf("trace sample ratio<%v> not within 0-1", settings.SampleRatio)
+	}
+
+	var opts []otlptracehttp.Option
+	if strings.Contains(settings.Endpoint, "://") {
+		opts = append(opts, otlptracehttp.WithEndpointURL(settings.Endpoint))
+	} else {
+		opts = append(opts, otlptracehttp.WithEndpoint(settings.Endpoint))
+		if settings.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+	}
+
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create OTLP trace exporter")
+	}
+
+	serviceName := settings.ServiceName
+	if serviceName == "" {
+		serviceName = DefaultServiceName
+	}
+	res := resource.NewSchemaless(
+		attribute.String("service.name", serviceName),
+		attribute.String("service.version", version.Version),
+	)
+
+	sampleRatio := settings.SampleRatio
+	if sampleRatio == 0 {
+		sampleRatio = 1
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	)
+	activeTracer.Store(&tracerHolder{tracer: provider.Tracer(instrumentationName, trace.WithInstrumentationVersion(version.Version))})
+
+	return func(ctx context.Context) error {
+		activeTracer.Store(nil)
+		return errors.Wrap(provider.Shutdown(ctx), "failed to flush traces")
+	}, nil
+}
+
+// Enabled returns true if traces are exported
+func Enabled() bool {
+	return currentTracer() != nil
+}
+
+func currentTracer() trace.Tracer {
+	holder := activeTracer.Load()
+	if holder == nil {
+		return nil
+	}
+	return holder.tracer
+}
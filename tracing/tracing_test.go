@@ -0,0 +1,153 @@
+package tracing
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// collectorStandIn minimal OTLP/HTTP collector keeping received spans in memory
+type collectorStandIn struct {
+	mu    sync.Mutex
+	spans []*tracepb.Span
+}
+
+func (collector *collectorStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	raw, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	var req collectortrace.ExportTraceServiceRequest
+	if err := proto.Unmarshal(raw, &req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+	for _, resourceSpans := range req.GetResourceSpans() {
+		for _, scopeSpans := range resourceSpans.GetScopeSpans() {
+			collector.spans = append(collector.spans, scopeSpans.GetSpans()...)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	body, _ := proto.Marshal(&collectortrace.ExportTraceServiceResponse{})
+	_, _ = w.Write(body)
+}
+
+func (collector *collectorStandIn) span(name string) *tracepb.Span {
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+	for _, span := range collector.spans {
+		if span.GetName() == name {
+			return span
+		}
+	}
+	return nil
+}
+
+func TestSessionTrace(t *testing.T) {
+	// disabled tracing should be no-op
+	var disabled *SessionTrace
+	disabled.StartAction("openapp", "", 1)
+	disabled.EndAction(true, nil)
+	disabled.End(nil)
+	if StartSession(1, 1, "user") != nil {
+		t.Fatal("expected nil session trace when tracing is disabled")
+	}
+
+	collector := &collectorStandIn{}
+	collectorServer := httptest.NewServer(collector)
+	defer collectorServer.Close()
+
+	var traceparent string
+	restServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+	}))
+	defer restServer.Close()
+
+	stop, err := Setup(context.Background(), Settings{Endpoint: collectorServer.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sessionTrace := StartSession(1, 2, "user1")
+	if sessionTrace == nil {
+		t.Fatal("expected session trace when tracing is enabled")
+	}
+
+	sessionTrace.StartAction("openapp", "open", 1)
+	header := sessionTrace.InjectHeaders(http.Header{"X-Custom": []string{"value"}})
+	if header.Get("traceparent") == "" || header.Get("X-Custom") != "value" {
+		t.Errorf("unexpected websocket headers<%v>", header)
+	}
+
+	end := sessionTrace.StartEngineRequest("OpenDoc", "", -1)
+	now := time.Now()
+	end(now.Add(-100*time.Millisecond), now, 100, 200, nil)
+
+	req, err := http.NewRequest(http.MethodGet, restServer.URL+"/api/v1/items", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tracedReq, endRequest := sessionTrace.StartRequest(req)
+	resp, err := http.DefaultClient.Do(tracedReq)
+	endRequest(resp, err)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if traceparent == "" {
+		t.Error("expected traceparent header on REST request")
+	}
+	if req.Header.Get("traceparent") != "" {
+		t.Error("original request should not be modified")
+	}
+
+	sessionTrace.EndAction(false, errors.New("failed"))
+	sessionTrace.End(nil)
+
+	if err := stop(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if Enabled() {
+		t.Error("expected tracing to be disabled after stop")
+	}
+
+	sessionSpan := collector.span("session")
+	actionSpan := collector.span("openapp")
+	engineSpan := collector.span("OpenDoc")
+	restSpan := collector.span("GET /api/v1/items")
+	for name, span := range map[string]*tracepb.Span{"session": sessionSpan, "action": actionSpan, "engine": engineSpan, "rest": restSpan} {
+		if span == nil {
+			t.Fatalf("%s span not exported", name)
+		}
+	}
+
+	if string(actionSpan.GetParentSpanId()) != string(sessionSpan.GetSpanId()) {
+		t.Error("action span should be child of session span")
+	}
+	if string(engineSpan.GetParentSpanId()) != string(actionSpan.GetSpanId()) {
+		t.Error("engine span should be child of action span")
+	}
+	if string(restSpan.GetParentSpanId()) != string(actionSpan.GetSpanId()) {
+		t.Error("REST span should be child of action span")
+	}
+	if actionSpan.GetStatus().GetCode() != tracepb.Status_STATUS_CODE_ERROR {
+		t.Error("failed action should have error status")
+	}
+	if engineSpan.GetEndTimeUnixNano()-engineSpan.GetStartTimeUnixNano() != uint64(100*time.Millisecond) {
+		t.Error("engine span should use measured timestamps")
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/qlik-oss/gopherciser/globals"
 	"github.com/qlik-oss/gopherciser/helpers"
+	"github.com/qlik-oss/gopherciser/logger"
 )
 
 var (
@@ -93,6 +94,11 @@ func (err DisconnectError) Error() string {
 	return fmt.Sprintf("Websocket<%s> disconnected (%s)", err.Type, err.Err)
 }
 
+// ErrorCategory implements logger.ErrorCategorizer interface
+func (err DisconnectError) ErrorCategory() logger.ErrorCategory {
+	return logger.ErrorCategoryDisconnect
+}
+
 // New Create new websocket dialer, use type to define a specific type which would be reported when getting a DisconnectError
 func New(url *neturl.URL, httpHeader http.Header, cookieJar http.CookieJar, timeout time.Duration, allowUntrusted bool, wstype string, maxFrameSize int64) (*WsDialer, error) {
 	if timeout.Nanoseconds() < 1 {